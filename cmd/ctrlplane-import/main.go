@@ -0,0 +1,258 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Command ctrlplane-import enumerates a Ctrlplane workspace and writes
+// Terraform import blocks plus skeleton resource configuration for systems,
+// deployments, environments, policies, and job agents. The output is a
+// starting point: run `terraform plan` afterwards and fill in anything the
+// skeletons leave out.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/ctrlplanedev/terraform-provider-ctrlplane/internal/api"
+	"github.com/gosimple/slug"
+)
+
+func main() {
+	var (
+		url       string
+		apiKey    string
+		workspace string
+		output    string
+	)
+
+	flag.StringVar(&url, "url", envOrDefault("CTRLPLANE_URL", "https://app.ctrlplane.dev"), "Ctrlplane API URL (CTRLPLANE_URL)")
+	flag.StringVar(&apiKey, "api-key", os.Getenv("CTRLPLANE_API_KEY"), "Ctrlplane API key (CTRLPLANE_API_KEY)")
+	flag.StringVar(&workspace, "workspace", os.Getenv("CTRLPLANE_WORKSPACE"), "workspace ID or slug (CTRLPLANE_WORKSPACE)")
+	flag.StringVar(&output, "out", "", "file to write HCL to (defaults to stdout)")
+	flag.Parse()
+
+	if apiKey == "" || workspace == "" {
+		log.Fatal("both -api-key and -workspace are required")
+	}
+
+	client, err := api.NewWorkspaceClient(context.Background(), url, apiKey, workspace)
+	if err != nil {
+		log.Fatalf("failed to create client: %s", err)
+	}
+
+	var w io.Writer = os.Stdout
+	if output != "" {
+		f, err := os.Create(output)
+		if err != nil {
+			log.Fatalf("failed to open %s: %s", output, err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	g := &generator{ctx: context.Background(), client: client, out: w, names: map[string]int{}}
+	for _, step := range []func() error{g.systems, g.environments, g.deployments, g.policies, g.jobAgents} {
+		if err := step(); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+type generator struct {
+	ctx    context.Context
+	client *api.WorkspaceClient
+	out    io.Writer
+	names  map[string]int
+}
+
+func (g *generator) systems() error {
+	systems := api.Paginate(g.ctx, func(ctx context.Context, limit, offset int) (api.Page[api.System], error) {
+		resp, err := g.client.Client.ListSystemsWithResponse(ctx, g.client.ID.String(), &api.ListSystemsParams{Limit: &limit, Offset: &offset})
+		if err != nil {
+			return api.Page[api.System]{}, fmt.Errorf("failed to list systems: %w", err)
+		}
+		if resp.JSON200 == nil {
+			return api.Page[api.System]{}, fmt.Errorf("failed to list systems: status %d", resp.StatusCode())
+		}
+		return api.Page[api.System]{Items: resp.JSON200.Items, Total: resp.JSON200.Total}, nil
+	})
+	for system, err := range systems {
+		if err != nil {
+			return err
+		}
+		g.write("ctrlplane_system", system.Name, system.Id, []attr{
+			{"name", quote(system.Name)},
+			{"description", optionalQuote(system.Description)},
+		})
+	}
+	return nil
+}
+
+func (g *generator) environments() error {
+	environments := api.Paginate(g.ctx, func(ctx context.Context, limit, offset int) (api.Page[api.Environment], error) {
+		resp, err := g.client.Client.ListEnvironmentsWithResponse(ctx, g.client.ID.String(), &api.ListEnvironmentsParams{Limit: &limit, Offset: &offset})
+		if err != nil {
+			return api.Page[api.Environment]{}, fmt.Errorf("failed to list environments: %w", err)
+		}
+		if resp.JSON200 == nil {
+			return api.Page[api.Environment]{}, fmt.Errorf("failed to list environments: status %d", resp.StatusCode())
+		}
+		return api.Page[api.Environment]{Items: resp.JSON200.Items, Total: resp.JSON200.Total}, nil
+	})
+	for env, err := range environments {
+		if err != nil {
+			return err
+		}
+		g.write("ctrlplane_environment", env.Name, env.Id, []attr{
+			{"name", quote(env.Name)},
+			{"description", optionalQuote(env.Description)},
+			{"resource_selector", optionalQuote(env.ResourceSelector)},
+		})
+	}
+	return nil
+}
+
+func (g *generator) deployments() error {
+	deployments := api.Paginate(g.ctx, func(ctx context.Context, limit, offset int) (api.Page[api.DeploymentAndSystems], error) {
+		resp, err := g.client.Client.ListDeploymentsWithResponse(ctx, g.client.ID.String(), &api.ListDeploymentsParams{Limit: &limit, Offset: &offset})
+		if err != nil {
+			return api.Page[api.DeploymentAndSystems]{}, fmt.Errorf("failed to list deployments: %w", err)
+		}
+		if resp.JSON200 == nil {
+			return api.Page[api.DeploymentAndSystems]{}, fmt.Errorf("failed to list deployments: status %d", resp.StatusCode())
+		}
+		return api.Page[api.DeploymentAndSystems]{Items: resp.JSON200.Items, Total: resp.JSON200.Total}, nil
+	})
+	for item, err := range deployments {
+		if err != nil {
+			return err
+		}
+		dep := item.Deployment
+		jobAgentSelector := &dep.JobAgentSelector
+		if dep.JobAgentSelector == "" {
+			jobAgentSelector = nil
+		}
+		g.write("ctrlplane_deployment", dep.Name, dep.Id, []attr{
+			{"name", quote(dep.Name)},
+			{"resource_selector", optionalQuote(dep.ResourceSelector)},
+			{"job_agent_selector", optionalQuote(jobAgentSelector)},
+		})
+	}
+	return nil
+}
+
+func (g *generator) policies() error {
+	policies := api.Paginate(g.ctx, func(ctx context.Context, limit, offset int) (api.Page[api.Policy], error) {
+		resp, err := g.client.Client.ListPoliciesWithResponse(ctx, g.client.ID.String(), &api.ListPoliciesParams{Limit: &limit, Offset: &offset})
+		if err != nil {
+			return api.Page[api.Policy]{}, fmt.Errorf("failed to list policies: %w", err)
+		}
+		if resp.JSON200 == nil {
+			return api.Page[api.Policy]{}, fmt.Errorf("failed to list policies: status %d", resp.StatusCode())
+		}
+		return api.Page[api.Policy]{Items: resp.JSON200.Items, Total: resp.JSON200.Total}, nil
+	})
+	for policy, err := range policies {
+		if err != nil {
+			return err
+		}
+		attrs := []attr{
+			{"name", quote(policy.Name)},
+			{"description", optionalQuote(policy.Description)},
+			{"selector", quote(policy.Selector)},
+			{"priority", fmt.Sprintf("%d", policy.Priority)},
+			{"enabled", fmt.Sprintf("%t", policy.Enabled)},
+		}
+		if len(policy.Rules) > 0 {
+			attrs = append(attrs, attr{"#", fmt.Sprintf("%d rule(s) not generated; see terraform plan output", len(policy.Rules))})
+		}
+		g.write("ctrlplane_policy", policy.Name, policy.Id, attrs)
+	}
+	return nil
+}
+
+func (g *generator) jobAgents() error {
+	agents := api.Paginate(g.ctx, func(ctx context.Context, limit, offset int) (api.Page[api.JobAgent], error) {
+		resp, err := g.client.Client.ListJobAgentsWithResponse(ctx, g.client.ID.String(), &api.ListJobAgentsParams{Limit: &limit, Offset: &offset})
+		if err != nil {
+			return api.Page[api.JobAgent]{}, fmt.Errorf("failed to list job agents: %w", err)
+		}
+		if resp.JSON200 == nil {
+			return api.Page[api.JobAgent]{}, fmt.Errorf("failed to list job agents: status %d", resp.StatusCode())
+		}
+		return api.Page[api.JobAgent]{Items: resp.JSON200.Items, Total: resp.JSON200.Total}, nil
+	})
+	for agent, err := range agents {
+		if err != nil {
+			return err
+		}
+		g.write("ctrlplane_job_agent", agent.Name, agent.Id, []attr{
+			{"name", quote(agent.Name)},
+			{"#", fmt.Sprintf("type %q: add the matching configuration block", agent.Type)},
+		})
+	}
+	return nil
+}
+
+// attr is a single line of generated configuration. A name of "#" renders
+// value as a comment; empty values are skipped.
+type attr struct {
+	name  string
+	value string
+}
+
+func (g *generator) write(resourceType, name, id string, attrs []attr) {
+	label := g.label(resourceType, name)
+
+	fmt.Fprintf(g.out, "import {\n  to = %s.%s\n  id = %s\n}\n\n", resourceType, label, quote(id))
+	fmt.Fprintf(g.out, "resource %q %q {\n", resourceType, label)
+	for _, a := range attrs {
+		switch {
+		case a.name == "#":
+			fmt.Fprintf(g.out, "  # %s\n", a.value)
+		case a.value != "":
+			fmt.Fprintf(g.out, "  %s = %s\n", a.name, a.value)
+		}
+	}
+	fmt.Fprint(g.out, "}\n\n")
+}
+
+// label turns a display name into a unique Terraform resource label.
+func (g *generator) label(resourceType, name string) string {
+	label := strings.ReplaceAll(slug.Make(name), "-", "_")
+	if label == "" || (label[0] >= '0' && label[0] <= '9') {
+		label = "r_" + label
+	}
+
+	key := resourceType + "." + label
+	g.names[key]++
+	if n := g.names[key]; n > 1 {
+		label = fmt.Sprintf("%s_%d", label, n)
+	}
+	return label
+}
+
+// quote renders value as an HCL string literal, escaping template sequences.
+func quote(value string) string {
+	quoted := fmt.Sprintf("%q", value)
+	quoted = strings.ReplaceAll(quoted, "${", "$${")
+	return strings.ReplaceAll(quoted, "%{", "%%{")
+}
+
+func optionalQuote(value *string) string {
+	if value == nil || *value == "" {
+		return ""
+	}
+	return quote(*value)
+}
+
+func envOrDefault(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
@@ -0,0 +1,19 @@
+data "ctrlplane_environment_progression_matrix" "chain" {
+  environment_ids = [
+    ctrlplane_environment.dev.id,
+    ctrlplane_environment.qa.id,
+    ctrlplane_environment.prod.id,
+  ]
+}
+
+resource "ctrlplane_policy" "progression" {
+  for_each = data.ctrlplane_environment_progression_matrix.chain.progressions
+
+  name     = "progression-${each.value.position}"
+  selector = each.value.selector
+
+  environment_progression {
+    depends_on_environment_selector = each.value.depends_on_environment_selector
+    minimum_success_percentage      = 100
+  }
+}
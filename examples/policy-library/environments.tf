@@ -0,0 +1,17 @@
+resource "ctrlplane_environment" "staging" {
+  name              = "policy-library-staging"
+  description       = "Staging environment"
+  resource_selector = "resource.metadata['environment'] == 'staging'"
+  metadata = {
+    tier = "standard"
+  }
+}
+
+resource "ctrlplane_environment" "production" {
+  name              = "policy-library-production"
+  description       = "Production environment"
+  resource_selector = "resource.metadata['environment'] == 'production'"
+  metadata = {
+    tier = "critical"
+  }
+}
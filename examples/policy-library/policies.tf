@@ -0,0 +1,60 @@
+# Wait at least an hour between production rollouts.
+resource "ctrlplane_policy" "cooldown" {
+  name     = "policy-library-production-cooldown"
+  priority = 10
+  selector = "environment.name == '${ctrlplane_environment.production.name}'"
+
+  version_cooldown {
+    duration = "1h"
+  }
+}
+
+# Only deploy critical environments during weekday business hours.
+resource "ctrlplane_policy" "business_hours" {
+  name        = "policy-library-business-hours"
+  description = "Only allow deployments during business hours on weekdays"
+  priority    = 5
+  selector    = "environment.metadata['tier'] == 'critical'"
+
+  deployment_window {
+    rrule            = "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR;BYHOUR=9;BYMINUTE=0;BYSECOND=0"
+    duration_minutes = 480
+    timezone         = "America/New_York"
+    allow_window     = true
+  }
+}
+
+# Require a human approval before production.
+resource "ctrlplane_policy" "approval" {
+  name     = "policy-library-production-approval"
+  priority = 20
+  selector = "environment.name == '${ctrlplane_environment.production.name}'"
+
+  any_approval {
+    min_approvals = 1
+  }
+}
+
+# Promote to production only after staging has succeeded.
+resource "ctrlplane_policy" "progression" {
+  name     = "policy-library-progression"
+  priority = 15
+  selector = "environment.name == '${ctrlplane_environment.production.name}'"
+
+  environment_progression {
+    depends_on_environment_selector = "environment.name == '${ctrlplane_environment.staging.name}'"
+    minimum_success_percentage      = 100
+  }
+}
+
+# Keep pre-release versions out of production.
+resource "ctrlplane_policy" "stable_versions" {
+  name     = "policy-library-stable-versions"
+  priority = 25
+  selector = "environment.name == '${ctrlplane_environment.production.name}'"
+
+  version_selector {
+    selector    = "!version.tag.contains('-')"
+    description = "Pre-release versions cannot be deployed to production"
+  }
+}
@@ -0,0 +1,28 @@
+resource "ctrlplane_deployment" "this" {
+  name               = "release-pin-api"
+  resource_selector  = "resource.kind == 'release-pin'"
+  job_agent_selector = "jobAgent.id == \"${ctrlplane_job_agent.this.id}\""
+
+  test_runner {
+    delay_seconds = 10
+    status        = "successful"
+    message       = "Test runner job agent"
+  }
+}
+
+resource "ctrlplane_deployment_system_link" "this" {
+  deployment_id = ctrlplane_deployment.this.id
+  system_id     = ctrlplane_system.this.id
+}
+
+resource "ctrlplane_deployment_version" "v1" {
+  deployment_id = ctrlplane_deployment.this.id
+  tag           = "v1.0.0"
+}
+
+resource "ctrlplane_deployment_version" "v2" {
+  deployment_id = ctrlplane_deployment.this.id
+  tag           = "v2.0.0"
+
+  depends_on = [ctrlplane_deployment_version.v1]
+}
@@ -0,0 +1,13 @@
+resource "ctrlplane_environment" "production" {
+  name              = "release-pin-production"
+  description       = "Production environment"
+  resource_selector = "resource.kind == 'release-pin' && resource.metadata['environment'] == 'production'"
+  metadata = {
+    environment = "production"
+  }
+}
+
+resource "ctrlplane_environment_system_link" "production" {
+  environment_id = ctrlplane_environment.production.id
+  system_id      = ctrlplane_system.this.id
+}
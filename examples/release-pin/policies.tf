@@ -0,0 +1,23 @@
+# Ctrlplane has no dedicated pin API, so a pin is a high-priority policy whose
+# version selector only allows one version for this deployment in production.
+#
+# To freeze production during an incident:
+#
+#   terraform apply -var pinned_version=v1.0.0
+#
+# To release the pin, apply again without pinned_version. Only the pin policy is
+# removed, so any pin or policy that applied before is in effect again, and the
+# plan and state history record who pinned what and when.
+resource "ctrlplane_policy" "pin" {
+  count = var.pinned_version == null ? 0 : 1
+
+  name        = "release-pin-production"
+  description = "Break-glass pin of ${ctrlplane_deployment.this.name} in production to ${var.pinned_version}"
+  priority    = 1000
+  selector    = "deployment.id == '${ctrlplane_deployment.this.id}' && environment.id == '${ctrlplane_environment.production.id}'"
+
+  version_selector {
+    selector    = "version.tag == '${var.pinned_version}'"
+    description = "Production is pinned to ${var.pinned_version}; unset pinned_version to release the pin"
+  }
+}
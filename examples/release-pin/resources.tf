@@ -0,0 +1,11 @@
+resource "ctrlplane_resource_provider" "this" {
+  name = "release-pin"
+
+  resource {
+    name       = "production-cluster"
+    identifier = "release-pin-production-cluster"
+    kind       = "release-pin"
+    version    = "ctrlplane.dev/release-pin/v1"
+    metadata   = { environment = "production" }
+  }
+}
@@ -0,0 +1,4 @@
+resource "ctrlplane_system" "this" {
+  name        = "release-pin"
+  description = "Example system whose production environment can be pinned to a version"
+}
@@ -0,0 +1,21 @@
+variable "workspace" {
+  type        = string
+  description = "The workspace to use"
+}
+
+variable "url" {
+  type        = string
+  description = "The URL of the Ctrlplane API"
+}
+
+variable "api_key" {
+  type        = string
+  description = "The API key for the Ctrlplane API"
+  sensitive   = true
+}
+
+variable "pinned_version" {
+  type        = string
+  description = "Version tag to pin production to, e.g. \"v1.0.0\". Leave null to release the pin and let production follow the latest version again."
+  default     = null
+}
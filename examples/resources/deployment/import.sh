@@ -1 +1,5 @@
+# Import by ID, by slug, by <system-slug>/<deployment-slug>, or with a
+# <workspace-slug>/ prefix on either form
 terraform import ctrlplane_deployment.example <deployment-id>
+terraform import ctrlplane_deployment.example my-system/my-deployment
+terraform import ctrlplane_deployment.example my-workspace/my-system/my-deployment
@@ -26,3 +26,12 @@ resource "ctrlplane_deployment_variable_value" "reference_example" {
     path      = ["metadata", "cluster_name"]
   }
 }
+
+# Sensitive value example (Terraform 1.11+): the secret never lands in state
+resource "ctrlplane_deployment_variable_value" "sensitive_example" {
+  variable_id = ctrlplane_deployment_variable.example.id
+  priority    = 3
+
+  sensitive_value         = var.api_token
+  sensitive_value_version = 1
+}
@@ -0,0 +1,6 @@
+# Imports the listed values under the names used in configuration
+terraform import ctrlplane_deployment_variable_values.example <variable-id>/default=<value-id>,us-east-1=<value-id>
+
+# Imports every value of the variable, keyed by value ID. The keys will not
+# match the configuration, so the next plan replaces every value.
+terraform import ctrlplane_deployment_variable_values.example <variable-id>
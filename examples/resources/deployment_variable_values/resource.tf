@@ -0,0 +1,29 @@
+variable "replicas_by_region" {
+  type = map(number)
+  default = {
+    us-east-1 = 6
+    eu-west-1 = 3
+  }
+}
+
+resource "ctrlplane_deployment_variable_values" "replicas" {
+  variable_id = ctrlplane_deployment_variable.replicas.id
+
+  values = merge(
+    {
+      default = {
+        priority      = 0
+        literal_value = "2"
+        value_type    = "number"
+      }
+    },
+    {
+      for region, count in var.replicas_by_region : region => {
+        priority          = 10
+        resource_selector = "resource.metadata['region'] == '${region}'"
+        literal_value     = tostring(count)
+        value_type        = "number"
+      }
+    },
+  )
+}
@@ -1 +1,3 @@
+# Import by ID, by name, or by <workspace-slug>/<environment-name>
 terraform import ctrlplane_environment.example <environment-id>
+terraform import ctrlplane_environment.example my-workspace/production
@@ -1 +1,3 @@
+# Import by ID, by name, or by <workspace-slug>/<job-agent-name>
 terraform import ctrlplane_job_agent.example <job-agent-id>
+terraform import ctrlplane_job_agent.example my-workspace/my-agent
@@ -1 +1,3 @@
+# Import by ID, by name, or by <workspace-slug>/<policy-name>
 terraform import ctrlplane_policy.example <policy-id>
+terraform import ctrlplane_policy.example my-workspace/my-policy
@@ -1 +1,3 @@
+# Import by ID, by slug, or by <workspace-slug>/<system-slug>
 terraform import ctrlplane_system.example <system-id>
+terraform import ctrlplane_system.example my-workspace/my-system
@@ -0,0 +1,29 @@
+resource "ctrlplane_deployment" "this" {
+  name               = "simple-deployment-api"
+  resource_selector  = "resource.kind == 'simple-deployment' && resource.version == 'ctrlplane.dev/simple-deployment/v1'"
+  job_agent_selector = "jobAgent.id == \"${ctrlplane_job_agent.this.id}\""
+
+  test_runner {
+    delay_seconds = 10
+    status        = "successful"
+    message       = "Test runner job agent"
+  }
+}
+
+resource "ctrlplane_deployment_system_link" "this" {
+  deployment_id = ctrlplane_deployment.this.id
+  system_id     = ctrlplane_system.this.id
+}
+
+resource "ctrlplane_deployment_version" "v1" {
+  deployment_id = ctrlplane_deployment.this.id
+  tag           = "v1.0.0"
+
+  config = jsonencode({
+    image = "nginx:1.27"
+  })
+
+  metadata = {
+    commit = "0000000"
+  }
+}
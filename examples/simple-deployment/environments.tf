@@ -0,0 +1,13 @@
+resource "ctrlplane_environment" "staging" {
+  name              = "simple-deployment-staging"
+  description       = "Staging environment"
+  resource_selector = "resource.metadata['environment'] == 'staging'"
+  metadata = {
+    environment = "staging"
+  }
+}
+
+resource "ctrlplane_environment_system_link" "staging" {
+  environment_id = ctrlplane_environment.staging.id
+  system_id      = ctrlplane_system.this.id
+}
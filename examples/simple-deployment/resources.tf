@@ -0,0 +1,11 @@
+resource "ctrlplane_resource_provider" "this" {
+  name = "simple-deployment"
+
+  resource {
+    name       = "staging-cluster"
+    identifier = "simple-deployment-staging-cluster"
+    kind       = "simple-deployment"
+    version    = "ctrlplane.dev/simple-deployment/v1"
+    metadata   = { environment = "staging" }
+  }
+}
@@ -0,0 +1,4 @@
+resource "ctrlplane_system" "this" {
+  name        = "simple-deployment"
+  description = "Example system with one environment and one deployment"
+}
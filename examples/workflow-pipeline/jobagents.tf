@@ -0,0 +1,19 @@
+resource "ctrlplane_job_agent" "build" {
+  name = "workflow-pipeline-build"
+
+  test_runner {
+    delay_seconds = 5
+    status        = "successful"
+    message       = "Build finished"
+  }
+}
+
+resource "ctrlplane_job_agent" "notify" {
+  name = "workflow-pipeline-notify"
+
+  test_runner {
+    delay_seconds = 1
+    status        = "successful"
+    message       = "Notification sent"
+  }
+}
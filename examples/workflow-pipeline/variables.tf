@@ -0,0 +1,15 @@
+variable "workspace" {
+  type        = string
+  description = "The workspace to use"
+}
+
+variable "url" {
+  type        = string
+  description = "The URL of the Ctrlplane API"
+}
+
+variable "api_key" {
+  type        = string
+  description = "The API key for the Ctrlplane API"
+  sensitive   = true
+}
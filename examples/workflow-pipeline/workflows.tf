@@ -0,0 +1,23 @@
+resource "ctrlplane_workflow" "pipeline" {
+  name = "workflow-pipeline"
+
+  inputs = jsonencode([
+    { key = "environment", type = "string", default = "staging" },
+    { key = "notify", type = "boolean", default = true },
+  ])
+
+  job_agent {
+    name     = "build"
+    ref      = ctrlplane_job_agent.build.id
+    config   = { delaySeconds = "5", status = "successful" }
+    selector = "true"
+  }
+
+  # Only dispatched when the run asks for a notification.
+  job_agent {
+    name     = "notify"
+    ref      = ctrlplane_job_agent.notify.id
+    config   = { delaySeconds = "1", status = "successful" }
+    selector = "inputs.notify == true"
+  }
+}
@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2021, 2026
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// DefaultCircuitBreakerThreshold is how many consecutive requests may fail
+// before the client stops sending requests.
+const DefaultCircuitBreakerThreshold = 5
+
+// ErrCircuitOpen matches errors returned for requests refused by an open
+// circuit breaker.
+var ErrCircuitOpen = errors.New("circuit open")
+
+// CircuitOpenError is returned without sending the request once the API has
+// failed Failures requests in a row. LastFailure describes the failure that
+// tripped the breaker.
+type CircuitOpenError struct {
+	Failures    int
+	LastFailure string
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf(
+		"the Ctrlplane API failed %d requests in a row (last failure: %s), so remaining requests are not sent; "+
+			"check that the API is reachable and run again",
+		e.Failures, e.LastFailure,
+	)
+}
+
+func (e *CircuitOpenError) Is(target error) bool {
+	return target == ErrCircuitOpen
+}
+
+// WithCircuitBreaker makes the client refuse every request once threshold
+// consecutive requests have failed with a network error or a 429 or 5xx
+// response, after any retries. Every resource shares the client, so one
+// outage fails the rest of the run quickly instead of each operation retrying
+// on its own. The breaker stays open for the life of the client. A threshold
+// of 0 disables it. It must be applied after WithRetry and before WithDryRun.
+func WithCircuitBreaker(threshold int) ClientOption {
+	return func(c *Client) error {
+		if threshold < 0 {
+			return fmt.Errorf("circuit breaker threshold must be non-negative, got %d", threshold)
+		}
+		if threshold == 0 {
+			return nil
+		}
+		doer := c.Client
+		if doer == nil {
+			doer = &http.Client{}
+		}
+		c.Client = &circuitBreakerDoer{doer: doer, threshold: threshold}
+		return nil
+	}
+}
+
+type circuitBreakerDoer struct {
+	doer      HttpRequestDoer
+	threshold int
+
+	mu          sync.Mutex
+	failures    int
+	lastFailure string
+}
+
+func (d *circuitBreakerDoer) Do(req *http.Request) (*http.Response, error) {
+	d.mu.Lock()
+	if d.failures >= d.threshold {
+		err := &CircuitOpenError{Failures: d.failures, LastFailure: d.lastFailure}
+		d.mu.Unlock()
+		return nil, err
+	}
+	d.mu.Unlock()
+
+	resp, err := d.doer.Do(req)
+
+	failure := ""
+	switch {
+	case err != nil:
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return resp, err
+		}
+		failure = fmt.Sprintf("%s %s: %s", req.Method, req.URL.Path, err)
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError:
+		failure = fmt.Sprintf("%s %s: status %d", req.Method, req.URL.Path, resp.StatusCode)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if failure == "" {
+		d.failures = 0
+		return resp, err
+	}
+	d.failures++
+	d.lastFailure = failure
+	if d.failures == d.threshold {
+		tflog.Warn(req.Context(), "Circuit breaker open: not sending further API requests", map[string]interface{}{
+			"failures":     d.failures,
+			"last_failure": failure,
+		})
+	}
+	return resp, err
+}
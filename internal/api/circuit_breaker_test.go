@@ -0,0 +1,106 @@
+// Copyright IBM Corp. 2021, 2026
+
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestCircuitBreakerDoer(t *testing.T) {
+	cases := map[string]struct {
+		statuses []int
+		// wantSent is how many of the requests reach the server.
+		wantSent int32
+		wantOpen bool
+	}{
+		"trips after threshold":      {statuses: []int{500, 503, 429, 200}, wantSent: 3, wantOpen: true},
+		"success resets":             {statuses: []int{500, 500, 200, 500, 500}, wantSent: 5},
+		"client errors do not count": {statuses: []int{404, 400, 409, 404}, wantSent: 4},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var sent atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := sent.Add(1) - 1
+				w.WriteHeader(tc.statuses[n])
+			}))
+			defer server.Close()
+
+			client := &Client{Server: server.URL, Client: server.Client()}
+			if err := WithCircuitBreaker(3)(client); err != nil {
+				t.Fatal(err)
+			}
+
+			var lastErr error
+			for range tc.statuses {
+				req, _ := http.NewRequest(http.MethodGet, server.URL+"/v1/systems", nil)
+				resp, err := client.Client.Do(req)
+				if resp != nil {
+					resp.Body.Close()
+				}
+				lastErr = err
+			}
+
+			if n := sent.Load(); n != tc.wantSent {
+				t.Errorf("server received %d requests, want %d", n, tc.wantSent)
+			}
+			if got := errors.Is(lastErr, ErrCircuitOpen); got != tc.wantOpen {
+				t.Fatalf("last request circuit open = %t, want %t (error %v)", got, tc.wantOpen, lastErr)
+			}
+			if tc.wantOpen {
+				var open *CircuitOpenError
+				if !errors.As(lastErr, &open) {
+					t.Fatalf("got error %T, want *CircuitOpenError", lastErr)
+				}
+				if open.Failures != 3 || !strings.Contains(open.LastFailure, "status 429") {
+					t.Errorf("got %+v, want 3 failures ending with status 429", open)
+				}
+			}
+		})
+	}
+}
+
+func TestCircuitBreakerDoerIgnoresCanceledRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	client := &Client{Server: server.URL, Client: server.Client()}
+	if err := WithCircuitBreaker(1)(client); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/v1/systems", nil)
+	if _, err := client.Client.Do(req); !errors.Is(err, context.Canceled) {
+		t.Fatalf("got error %v, want context.Canceled", err)
+	}
+
+	req, _ = http.NewRequest(http.MethodGet, server.URL+"/v1/systems", nil)
+	resp, err := client.Client.Do(req)
+	if err != nil {
+		t.Fatalf("request after cancellation: unexpected error: %v", err)
+	}
+	resp.Body.Close()
+}
+
+func TestWithCircuitBreakerDisabled(t *testing.T) {
+	doer := &http.Client{}
+	client := &Client{Client: doer}
+	if err := WithCircuitBreaker(0)(client); err != nil {
+		t.Fatal(err)
+	}
+	if client.Client != doer {
+		t.Errorf("a threshold of 0 wrapped the client")
+	}
+	if err := WithCircuitBreaker(-1)(client); err == nil {
+		t.Errorf("expected an error for a negative threshold")
+	}
+}
@@ -7,21 +7,31 @@ package api
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-func NewAPIKeyClientWithResponses(server string, apiKey string) (*ClientWithResponses, error) {
-	server = strings.TrimSuffix(server, "/")
-	server = strings.TrimSuffix(server, "/api")
-	return NewClientWithResponses(server+"/api",
+func NewAPIKeyClientWithResponses(server string, apiKey string, opts ...ClientOption) (*ClientWithResponses, error) {
+	return NewClientWithResponses(apiBaseURL(server), append([]ClientOption{
+		WithHTTPClient(newRetryingDoer(&http.Client{})),
 		WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
 			req.Header.Set("X-API-Key", apiKey)
 			return nil
 		}),
-	)
+	}, opts...)...)
+}
+
+// apiBaseURL returns the API root of a Ctrlplane endpoint, which may be given
+// with or without the /api suffix.
+func apiBaseURL(server string) string {
+	server = strings.TrimSuffix(server, "/")
+	server = strings.TrimSuffix(server, "/api")
+	return server + "/api"
 }
 
 func (c *ClientWithResponses) GetWorkspaceID(ctx context.Context, workspace string) uuid.UUID {
@@ -42,25 +52,125 @@ func (c *ClientWithResponses) GetWorkspaceID(ctx context.Context, workspace stri
 	return resp.JSON200.Id
 }
 
-func NewWorkspaceClient(endpoint string, apiKey string, workspace string) (*WorkspaceClient, error) {
-	client, err := NewAPIKeyClientWithResponses(endpoint, apiKey)
+func NewWorkspaceClient(ctx context.Context, endpoint string, apiKey string, workspace string, opts ...ClientOption) (*WorkspaceClient, error) {
+	client, err := NewAPIKeyClientWithResponses(endpoint, apiKey, opts...)
 	if err != nil {
 		return nil, err
 	}
-	workspaceID := client.GetWorkspaceID(context.Background(), workspace)
+	workspaceID := client.GetWorkspaceID(ctx, workspace)
 	if workspaceID == uuid.Nil {
 		return nil, errors.New("workspace not found")
 	}
 
+	slug, err := client.getWorkspaceSlug(ctx, workspace, workspaceID)
+	if err != nil {
+		tflog.Warn(ctx, "Could not look up the workspace slug; app_url and entity_url will be null", map[string]interface{}{
+			"workspace": workspaceID.String(),
+			"error":     err.Error(),
+		})
+	}
+
 	return &WorkspaceClient{
 		Url:    endpoint,
 		ID:     workspaceID,
+		Slug:   slug,
 		Client: client,
+		Features: Features{
+			UnmanagedConfigWarnings: true,
+			OrphanedValueWarnings:   true,
+			MetadataNullIsEmpty:     true,
+		},
 	}, nil
 }
 
+// getWorkspaceSlug returns the slug for a workspace configured by ID or slug.
+// A workspace configured by slug needs no request.
+func (c *ClientWithResponses) getWorkspaceSlug(ctx context.Context, workspace string, id uuid.UUID) (string, error) {
+	if _, err := uuid.Parse(workspace); err != nil {
+		return workspace, nil
+	}
+
+	resp, err := c.GetWorkspaceWithResponse(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if resp.JSON200 == nil {
+		return "", fmt.Errorf("unexpected status %d", resp.StatusCode())
+	}
+	return resp.JSON200.Slug, nil
+}
+
+// CheckAccess reads the configured workspace to confirm that the API is
+// reachable and that the API key can see the workspace. The API has no
+// dedicated health or token-scope endpoint, so this is the cheapest request
+// that exercises both. It returns the HTTP status code (0 when no response
+// was received) and an error describing the failure in terms of the provider
+// configuration.
+func (w *WorkspaceClient) CheckAccess(ctx context.Context) (int, error) {
+	resp, err := w.Client.GetWorkspaceWithResponse(ctx, w.ID)
+	if err != nil {
+		return 0, fmt.Errorf("could not reach the Ctrlplane API at %s: %w", w.Url, err)
+	}
+
+	status := resp.StatusCode()
+	switch status {
+	case http.StatusOK:
+		return status, nil
+	case http.StatusUnauthorized:
+		return status, errors.New("the API key was rejected (status 401); check api_key or CTRLPLANE_API_KEY")
+	case http.StatusForbidden:
+		return status, fmt.Errorf("the API key does not have access to workspace %s (status 403)", w.ID)
+	case http.StatusNotFound:
+		return status, fmt.Errorf("workspace %s was not found (status 404)", w.ID)
+	default:
+		return status, fmt.Errorf("unexpected status %d from %s", status, w.Url)
+	}
+}
+
 type WorkspaceClient struct {
-	ID     uuid.UUID `json:"id"`
-	Url    string    `json:"url"`
-	Client *ClientWithResponses
+	ID       uuid.UUID `json:"id"`
+	Url      string    `json:"url"`
+	Slug     string    `json:"slug"`
+	Client   WorkspaceAPI
+	Features Features
+	// DefaultMetadata is merged under the metadata of every system,
+	// environment, deployment, policy, and job agent the provider writes.
+	DefaultMetadata map[string]string
+	// PlanSummaryFile is the path resources write a JSON summary of their
+	// planned changes to, or "" to write none.
+	PlanSummaryFile string
+}
+
+// Features toggles optional provider behaviors, set from the features block
+// of the provider configuration. NewWorkspaceClient enables all of them.
+type Features struct {
+	// UnmanagedConfigWarnings warns when a job agent's config on the server
+	// has keys that its typed block does not manage.
+	UnmanagedConfigWarnings bool
+	// OrphanedValueWarnings warns when a refreshed deployment variable value
+	// belongs to a variable that no longer exists.
+	OrphanedValueWarnings bool
+	// MetadataNullIsEmpty stores missing metadata as an empty map and sends
+	// unset metadata as one, so the two are never reported as a diff.
+	MetadataNullIsEmpty bool
+}
+
+// AppURL returns the workspace's home page in the Ctrlplane UI, or "" when
+// the workspace slug is unknown.
+func (w *WorkspaceClient) AppURL() string {
+	if w.Slug == "" {
+		return ""
+	}
+	base := strings.TrimSuffix(strings.TrimSuffix(w.Url, "/"), "/api")
+	return base + "/" + url.PathEscape(w.Slug)
+}
+
+// EntityURL returns the UI page for the object with the given ID in a
+// workspace collection such as "deployments", or "" when it cannot be built.
+func (w *WorkspaceClient) EntityURL(collection, id string) string {
+	app := w.AppURL()
+	if app == "" || id == "" {
+		return ""
+	}
+	return app + "/" + collection + "/" + url.PathEscape(id)
 }
@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2021, 2026
+
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestNewWorkspaceClientSlug(t *testing.T) {
+	id := uuid.New()
+	workspaceJSON := fmt.Sprintf(`{"id":%q,"slug":"acme","name":"Acme","createdAt":"2026-01-01T00:00:00Z"}`, id)
+
+	cases := map[string]struct {
+		workspace   string
+		getStatus   int
+		wantSlug    string
+		wantWarning bool
+	}{
+		"configured by slug":   {workspace: "acme", wantSlug: "acme"},
+		"configured by id":     {workspace: id.String(), getStatus: http.StatusOK, wantSlug: "acme"},
+		"workspace read fails": {workspace: id.String(), getStatus: http.StatusForbidden, wantWarning: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/api/v1/workspaces/slug/acme":
+					fmt.Fprint(w, workspaceJSON)
+				case "/api/v1/workspaces/" + id.String():
+					w.WriteHeader(tc.getStatus)
+					fmt.Fprint(w, workspaceJSON)
+				default:
+					t.Errorf("unexpected request %s", r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			var output bytes.Buffer
+			ctx := tflogtest.RootLogger(t.Context(), &output)
+			client, err := NewWorkspaceClient(ctx, server.URL, "key", tc.workspace, WithRetry(0, time.Millisecond, time.Millisecond))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if client.ID != id {
+				t.Errorf("got ID %s, want %s", client.ID, id)
+			}
+			if client.Slug != tc.wantSlug {
+				t.Errorf("got slug %q, want %q", client.Slug, tc.wantSlug)
+			}
+			if got := strings.Contains(output.String(), "Could not look up the workspace slug"); got != tc.wantWarning {
+				t.Errorf("warning logged = %v, want %v: %s", got, tc.wantWarning, output.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+// Copyright IBM Corp. 2021, 2026
+
+// Compatibility shims for the generated client. When a spec refresh renames
+// an operation the provider calls, define a method here with the name listed
+// in WorkspaceAPI that calls the new one, and alias renamed request and
+// response types, e.g.
+//
+//	type GetEnvironmentResponse = GetEnvironmentByIdResponse
+//
+//	func (c *ClientWithResponses) GetEnvironmentWithResponse(ctx context.Context, workspaceId string, environmentId string, reqEditors ...RequestEditorFn) (*GetEnvironmentResponse, error) {
+//		return c.GetEnvironmentByIdWithResponse(ctx, workspaceId, environmentId, reqEditors...)
+//	}
+//
+// Remove a shim once its call sites have moved to the new name.
+
+package api
@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2021, 2026
+
+package api
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// WithDebugHTTP logs every request the client sends and the response to it
+// at debug level, with credential-like headers and body fields redacted. It
+// wraps the innermost HTTP client, so each retry and failover attempt is
+// logged on its own. It must be applied after WithRetry.
+func WithDebugHTTP() ClientOption {
+	return func(c *Client) error {
+		doer, ok := c.Client.(*retryingDoer)
+		if !ok {
+			return errors.New("WithDebugHTTP must be applied directly after WithRetry")
+		}
+		doer.doer = &debugDoer{doer: doer.doer}
+		return nil
+	}
+}
+
+type debugDoer struct {
+	doer HttpRequestDoer
+}
+
+func (d *debugDoer) Do(req *http.Request) (*http.Response, error) {
+	fields := map[string]interface{}{
+		"method":          req.Method,
+		"url":             req.URL.String(),
+		"request_headers": redactHeaders(req.Header),
+	}
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		fields["request_body"] = redactPayload(body)
+	}
+
+	start := time.Now()
+	resp, err := d.doer.Do(req)
+	fields["duration_ms"] = time.Since(start).Milliseconds()
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(req.Context(), "Ctrlplane API request failed", fields)
+		return resp, err
+	}
+
+	fields["status"] = resp.StatusCode
+	if resp.Body != nil {
+		body, readErr := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		if readErr != nil {
+			fields["response_error"] = readErr.Error()
+		} else if len(body) > 0 {
+			fields["response_body"] = redactPayload(body)
+		}
+	}
+	tflog.Debug(req.Context(), "Ctrlplane API request", fields)
+	return resp, nil
+}
+
+// redactHeaders flattens headers for logging, hiding the values of
+// credential-like headers such as X-API-Key and Authorization.
+func redactHeaders(header http.Header) map[string]string {
+	redacted := make(map[string]string, len(header))
+	for name, values := range header {
+		if isSensitiveKey(name) || strings.EqualFold(name, "Authorization") {
+			redacted[name] = "(sensitive)"
+			continue
+		}
+		redacted[name] = strings.Join(values, ", ")
+	}
+	return redacted
+}
@@ -0,0 +1,119 @@
+// Copyright IBM Corp. 2021, 2026
+
+package api
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestRedactHeaders(t *testing.T) {
+	header := http.Header{}
+	header.Set("X-API-Key", "secret-key")
+	header.Set("Authorization", "Bearer secret-token")
+	header.Set("X-Auth-Token", "secret-token")
+	header.Add("Accept", "application/json")
+	header.Add("Accept", "text/plain")
+
+	want := map[string]string{
+		"X-Api-Key":     "(sensitive)",
+		"Authorization": "(sensitive)",
+		"X-Auth-Token":  "(sensitive)",
+		"Accept":        "application/json, text/plain",
+	}
+	if got := redactHeaders(header); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestRedactPayload(t *testing.T) {
+	cases := map[string]struct {
+		body string
+		want string
+	}{
+		"nested":    {body: `{"name":"web","config":{"apiKey":"k","token":"t","url":"https://example.com"}}`, want: `{"config":{"apiKey":"(sensitive)","token":"(sensitive)","url":"https://example.com"},"name":"web"}`},
+		"in list":   {body: `[{"password":"p","user":"u"}]`, want: `[{"password":"(sensitive)","user":"u"}]`},
+		"not json":  {body: `secret=value`, want: `(12 bytes)`},
+		"html kept": {body: `{"selector":"a < b && c"}`, want: `{"selector":"a < b && c"}`},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := redactPayload([]byte(tc.body)); got != tc.want {
+				t.Errorf("got %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDebugDoer(t *testing.T) {
+	const requestBody = `{"name":"web","config":{"apiKey":"request-secret"}}`
+	const responseBody = `{"id":"1","token":"response-secret"}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, _ := io.ReadAll(r.Body); string(got) != requestBody {
+			t.Errorf("server got body %q, want %q", got, requestBody)
+		}
+		w.WriteHeader(http.StatusAccepted)
+		io.WriteString(w, responseBody)
+	}))
+	defer server.Close()
+
+	client := &Client{Server: server.URL, Client: newRetryingDoer(server.Client())}
+	if err := WithRetry(0, time.Millisecond, time.Millisecond)(client); err != nil {
+		t.Fatal(err)
+	}
+	if err := WithDebugHTTP()(client); err != nil {
+		t.Fatal(err)
+	}
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(t.Context(), &output)
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPut, server.URL+"/v1/job-agents/1", strings.NewReader(requestBody))
+	req.Header.Set("X-API-Key", "header-secret")
+	resp, err := client.Client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if string(got) != responseBody {
+		t.Errorf("caller got body %q, want %q", got, responseBody)
+	}
+
+	logged := output.String()
+	for _, secret := range []string{"header-secret", "request-secret", "response-secret"} {
+		if strings.Contains(logged, secret) {
+			t.Errorf("log contains %q: %s", secret, logged)
+		}
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("decoding log: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("got %d log entries, want 1: %v", len(entries), entries)
+	}
+	entry := entries[0]
+	if entry["status"] != float64(http.StatusAccepted) || entry["method"] != http.MethodPut {
+		t.Errorf("got entry %v, want the method and status", entry)
+	}
+	if entry["request_body"] != `{"config":{"apiKey":"(sensitive)"},"name":"web"}` {
+		t.Errorf("request_body = %v, want apiKey redacted", entry["request_body"])
+	}
+}
+
+func TestWithDebugHTTPOrder(t *testing.T) {
+	client := &Client{Client: &http.Client{}}
+	if err := WithDebugHTTP()(client); err == nil {
+		t.Error("expected an error applying WithDebugHTTP without WithRetry")
+	}
+}
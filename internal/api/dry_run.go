@@ -0,0 +1,131 @@
+// Copyright IBM Corp. 2021, 2026
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ErrDryRun matches errors returned for write requests suppressed by
+// WithDryRun.
+var ErrDryRun = errors.New("dry run")
+
+// DryRunError describes a write request that was not sent because the client
+// is in dry-run mode. Payload has sensitive fields redacted.
+type DryRunError struct {
+	Method  string
+	URL     string
+	Payload string
+}
+
+func (e *DryRunError) Error() string {
+	if e.Payload == "" {
+		return fmt.Sprintf("dry run: %s %s was not sent", e.Method, e.URL)
+	}
+	return fmt.Sprintf("dry run: %s %s was not sent; payload: %s", e.Method, e.URL, e.Payload)
+}
+
+func (e *DryRunError) Is(target error) bool {
+	return target == ErrDryRun
+}
+
+// WithDryRun makes the client log and refuse every write request while still
+// sending reads, so the requests a configuration would send can be checked
+// against a live workspace without changing it. A refused write fails with a
+// DryRunError rather than a synthetic response: resources read back what they
+// wrote, which a write that was never sent cannot satisfy, so each resource
+// stops at its first write.
+func WithDryRun() ClientOption {
+	return func(c *Client) error {
+		doer := c.Client
+		if doer == nil {
+			doer = &http.Client{}
+		}
+		c.Client = &dryRunDoer{doer: doer}
+		return nil
+	}
+}
+
+type dryRunDoer struct {
+	doer HttpRequestDoer
+}
+
+func (d *dryRunDoer) Do(req *http.Request) (*http.Response, error) {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return d.doer.Do(req)
+	}
+
+	var payload string
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err == nil {
+			payload = redactPayload(body)
+		}
+	}
+
+	tflog.Info(req.Context(), "Dry run: skipping write request", map[string]interface{}{
+		"method":  req.Method,
+		"url":     req.URL.String(),
+		"payload": payload,
+	})
+
+	return nil, &DryRunError{Method: req.Method, URL: req.URL.String(), Payload: payload}
+}
+
+// redactPayload re-encodes a JSON body with values of credential-like keys
+// replaced. Non-JSON bodies are reported by size only.
+func redactPayload(body []byte) string {
+	var decoded interface{}
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		return fmt.Sprintf("(%d bytes)", len(body))
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(redactValue(decoded)); err != nil {
+		return fmt.Sprintf("(%d bytes)", len(body))
+	}
+	return strings.TrimSpace(buf.String())
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, inner := range v {
+			if isSensitiveKey(key) {
+				v[key] = "(sensitive)"
+				continue
+			}
+			v[key] = redactValue(inner)
+		}
+		return v
+	case []interface{}:
+		for i, inner := range v {
+			v[i] = redactValue(inner)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, marker := range []string{"key", "token", "secret", "password"} {
+		if strings.Contains(key, marker) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2021, 2026
+
+package api
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestDryRunDoer(t *testing.T) {
+	var writes atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writes.Add(1)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &Client{Server: server.URL, Client: server.Client()}
+	if err := WithDryRun()(client); err != nil {
+		t.Fatal(err)
+	}
+
+	read, _ := http.NewRequest(http.MethodGet, server.URL+"/v1/systems", nil)
+	resp, err := client.Client.Do(read)
+	if err != nil {
+		t.Fatalf("read: unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	body := `{"name":"web","config":{"apiKey":"secret-value","url":"https://example.com"}}`
+	write, _ := http.NewRequest(http.MethodPut, server.URL+"/v1/job-agents/1", strings.NewReader(body))
+	resp, err = client.Client.Do(write)
+	if resp != nil {
+		t.Errorf("write: got a response, want none")
+	}
+	if !errors.Is(err, ErrDryRun) {
+		t.Fatalf("write: got error %v, want ErrDryRun", err)
+	}
+	var dryRunErr *DryRunError
+	if !errors.As(err, &dryRunErr) {
+		t.Fatalf("write: got error %T, want *DryRunError", err)
+	}
+	if dryRunErr.Method != http.MethodPut {
+		t.Errorf("Method = %q, want PUT", dryRunErr.Method)
+	}
+	if strings.Contains(dryRunErr.Payload, "secret-value") || !strings.Contains(dryRunErr.Payload, `"apiKey":"(sensitive)"`) {
+		t.Errorf("Payload = %s, want apiKey redacted", dryRunErr.Payload)
+	}
+	if !strings.Contains(dryRunErr.Payload, `"url":"https://example.com"`) {
+		t.Errorf("Payload = %s, want other fields kept", dryRunErr.Payload)
+	}
+
+	if n := writes.Load(); n != 0 {
+		t.Errorf("server received %d writes, want 0", n)
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright IBM Corp. 2021, 2026
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// DefaultFailoverCooldown is how long an endpoint that failed a request is
+// skipped before it is tried again.
+const DefaultFailoverCooldown = 30 * time.Second
+
+// FailoverEndpoint is a replica of the control plane to send requests to
+// when the endpoints before it are unavailable.
+type FailoverEndpoint struct {
+	URL    string
+	APIKey string
+}
+
+// WithFailover sends requests to the first available of the client's own
+// server and endpoints, in order. An endpoint is unavailable for cooldown
+// after a request to it fails with a network error or a 502, 503, or 504
+// response, after any retries; the failed request then moves on to the next
+// endpoint. Once the cooldown passes the endpoint is tried first again, so
+// traffic returns to the primary when it recovers. Requests that are not safe
+// to repeat are sent to one endpoint only, since a failed create may still
+// have been applied. When every endpoint is unavailable they are all tried in
+// order. It must be applied after WithRetry and before WithCircuitBreaker.
+func WithFailover(endpoints []FailoverEndpoint, cooldown time.Duration) ClientOption {
+	return func(c *Client) error {
+		if len(endpoints) == 0 {
+			return nil
+		}
+		if cooldown <= 0 {
+			return fmt.Errorf("failover cooldown must be positive, got %s", cooldown)
+		}
+
+		primary, err := url.Parse(strings.TrimSuffix(c.Server, "/") + "/")
+		if err != nil {
+			return fmt.Errorf("invalid server URL %q: %w", c.Server, err)
+		}
+		targets := []*failoverTarget{{base: primary}}
+		for _, endpoint := range endpoints {
+			base, err := url.Parse(apiBaseURL(endpoint.URL) + "/")
+			if err != nil || base.Scheme == "" || base.Host == "" {
+				return fmt.Errorf("invalid failover endpoint URL %q", endpoint.URL)
+			}
+			targets = append(targets, &failoverTarget{base: base, apiKey: endpoint.APIKey})
+		}
+
+		doer := c.Client
+		if doer == nil {
+			doer = &http.Client{}
+		}
+		c.Client = &failoverDoer{doer: doer, targets: targets, cooldown: cooldown}
+		return nil
+	}
+}
+
+type failoverTarget struct {
+	base *url.URL
+	// apiKey replaces the request's X-API-Key header when set.
+	apiKey    string
+	downUntil time.Time
+}
+
+type failoverDoer struct {
+	doer     HttpRequestDoer
+	targets  []*failoverTarget
+	cooldown time.Duration
+
+	mu sync.Mutex
+}
+
+func (d *failoverDoer) Do(req *http.Request) (*http.Response, error) {
+	order := d.order()
+	if !isIdempotentRequest(req) {
+		order = order[:1]
+	}
+
+	var (
+		resp *http.Response
+		err  error
+	)
+	for i, target := range order {
+		attempt, rewriteErr := d.rewrite(req, target)
+		if rewriteErr != nil {
+			return nil, rewriteErr
+		}
+
+		resp, err = d.doer.Do(attempt)
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) || !isEndpointFailure(resp, err) {
+			d.markUp(target)
+			return resp, err
+		}
+
+		d.markDown(target)
+		if i == len(order)-1 {
+			break
+		}
+		if resp != nil {
+			resp.Body.Close()
+		}
+		tflog.Warn(req.Context(), "Ctrlplane API endpoint unavailable, failing over", map[string]interface{}{
+			"endpoint": target.base.Host,
+			"next":     order[i+1].base.Host,
+			"failure":  describeEndpointFailure(resp, err),
+		})
+	}
+	return resp, err
+}
+
+// order returns the targets to try: the available ones in configured order,
+// or all of them when none is available.
+func (d *failoverDoer) order() []*failoverTarget {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := time.Now()
+	var available []*failoverTarget
+	for _, target := range d.targets {
+		if !now.Before(target.downUntil) {
+			available = append(available, target)
+		}
+	}
+	if len(available) == 0 {
+		return append([]*failoverTarget(nil), d.targets...)
+	}
+	return available
+}
+
+func (d *failoverDoer) markDown(target *failoverTarget) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	target.downUntil = time.Now().Add(d.cooldown)
+}
+
+func (d *failoverDoer) markUp(target *failoverTarget) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	target.downUntil = time.Time{}
+}
+
+// rewrite returns req addressed to target. Requests for the primary are sent
+// unchanged.
+func (d *failoverDoer) rewrite(req *http.Request, target *failoverTarget) (*http.Request, error) {
+	if target == d.targets[0] {
+		return req, nil
+	}
+
+	primary := d.targets[0].base.String()
+	if !strings.HasPrefix(req.URL.String(), primary) {
+		return req, nil
+	}
+	rewritten, err := url.Parse(target.base.String() + strings.TrimPrefix(req.URL.String(), primary))
+	if err != nil {
+		return nil, err
+	}
+
+	attempt := req.Clone(req.Context())
+	attempt.URL = rewritten
+	attempt.Host = rewritten.Host
+	if target.apiKey != "" {
+		attempt.Header.Set("X-API-Key", target.apiKey)
+	}
+	if req.Body != nil && req.Body != http.NoBody {
+		if req.GetBody == nil {
+			return nil, fmt.Errorf("%s %s cannot be failed over: the request body cannot be replayed", req.Method, req.URL.Path)
+		}
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		attempt.Body = body
+	}
+	return attempt, nil
+}
+
+func isEndpointFailure(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch resp.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+func describeEndpointFailure(resp *http.Response, err error) string {
+	if err != nil {
+		return err.Error()
+	}
+	return fmt.Sprintf("status %d", resp.StatusCode)
+}
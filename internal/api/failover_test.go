@@ -0,0 +1,150 @@
+// Copyright IBM Corp. 2021, 2026
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// failoverTestServer counts requests and answers each with the current
+// status, recording the path and API key of the last one.
+type failoverTestServer struct {
+	*httptest.Server
+	apiKey   string
+	status   atomic.Int32
+	requests atomic.Int32
+	lastPath atomic.Value
+	lastKey  atomic.Value
+}
+
+func newFailoverTestServer(t *testing.T, status int, apiKey string) *failoverTestServer {
+	t.Helper()
+	s := &failoverTestServer{apiKey: apiKey}
+	s.status.Store(int32(status))
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.requests.Add(1)
+		s.lastPath.Store(r.URL.Path)
+		s.lastKey.Store(r.Header.Get("X-API-Key"))
+		w.WriteHeader(int(s.status.Load()))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func newFailoverTestClient(t *testing.T, primary *failoverTestServer, cooldown time.Duration, secondaries ...*failoverTestServer) *Client {
+	t.Helper()
+	endpoints := make([]FailoverEndpoint, 0, len(secondaries))
+	for _, secondary := range secondaries {
+		endpoints = append(endpoints, FailoverEndpoint{URL: secondary.URL, APIKey: secondary.apiKey})
+	}
+	client := &Client{Server: apiBaseURL(primary.URL), Client: http.DefaultClient}
+	if err := WithFailover(endpoints, cooldown)(client); err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+const primaryAPIKey = "primary-key"
+
+func doFailoverRequest(t *testing.T, client *Client, method string) int {
+	t.Helper()
+	req, _ := http.NewRequest(method, client.Server+"/v1/workspaces/ws/systems", nil)
+	req.Header.Set("X-API-Key", primaryAPIKey)
+	resp, err := client.Client.Do(req)
+	if err != nil {
+		t.Fatalf("%s: unexpected error: %v", method, err)
+	}
+	resp.Body.Close()
+	return resp.StatusCode
+}
+
+func TestFailoverDoerRotatesEndpoints(t *testing.T) {
+	primary := newFailoverTestServer(t, http.StatusServiceUnavailable, primaryAPIKey)
+	secondary := newFailoverTestServer(t, http.StatusBadGateway, "secondary-key")
+	tertiary := newFailoverTestServer(t, http.StatusOK, "tertiary-key")
+	client := newFailoverTestClient(t, primary, time.Hour, secondary, tertiary)
+
+	if status := doFailoverRequest(t, client, http.MethodGet); status != http.StatusOK {
+		t.Fatalf("got status %d, want 200 from the tertiary endpoint", status)
+	}
+	for name, server := range map[string]*failoverTestServer{"primary": primary, "secondary": secondary, "tertiary": tertiary} {
+		if n := server.requests.Load(); n != 1 {
+			t.Errorf("%s received %d requests, want 1", name, n)
+		}
+	}
+	if got := tertiary.lastPath.Load(); got != "/api/v1/workspaces/ws/systems" {
+		t.Errorf("tertiary got path %v, want the primary's path under its own base", got)
+	}
+	if got := tertiary.lastKey.Load(); got != "tertiary-key" {
+		t.Errorf("tertiary got API key %v, want its own", got)
+	}
+
+	// Endpoints that failed are skipped until their cooldown passes.
+	if status := doFailoverRequest(t, client, http.MethodGet); status != http.StatusOK {
+		t.Fatalf("got status %d, want 200", status)
+	}
+	if n := primary.requests.Load() + secondary.requests.Load(); n != 2 {
+		t.Errorf("unavailable endpoints received %d requests in total, want 2", n)
+	}
+	if n := tertiary.requests.Load(); n != 2 {
+		t.Errorf("tertiary received %d requests, want 2", n)
+	}
+}
+
+func TestFailoverDoerReturnsToPrimary(t *testing.T) {
+	primary := newFailoverTestServer(t, http.StatusGatewayTimeout, primaryAPIKey)
+	secondary := newFailoverTestServer(t, http.StatusOK, "secondary-key")
+	client := newFailoverTestClient(t, primary, 10*time.Millisecond, secondary)
+
+	doFailoverRequest(t, client, http.MethodGet)
+	primary.status.Store(http.StatusOK)
+	time.Sleep(20 * time.Millisecond)
+
+	if status := doFailoverRequest(t, client, http.MethodGet); status != http.StatusOK {
+		t.Fatalf("got status %d, want 200", status)
+	}
+	if n := primary.requests.Load(); n != 2 {
+		t.Errorf("primary received %d requests, want 2 once its cooldown passed", n)
+	}
+	if got := primary.lastKey.Load(); got != primaryAPIKey {
+		t.Errorf("primary got API key %v, want the request's own", got)
+	}
+	if n := secondary.requests.Load(); n != 1 {
+		t.Errorf("secondary received %d requests, want 1", n)
+	}
+}
+
+func TestFailoverDoerDoesNotRepeatNonIdempotentRequests(t *testing.T) {
+	primary := newFailoverTestServer(t, http.StatusServiceUnavailable, primaryAPIKey)
+	secondary := newFailoverTestServer(t, http.StatusOK, "secondary-key")
+	client := newFailoverTestClient(t, primary, time.Hour, secondary)
+
+	if status := doFailoverRequest(t, client, http.MethodPost); status != http.StatusServiceUnavailable {
+		t.Errorf("got status %d, want the primary's 503", status)
+	}
+	if n := secondary.requests.Load(); n != 0 {
+		t.Errorf("secondary received %d requests, want 0", n)
+	}
+}
+
+func TestFailoverDoerTriesAllWhenAllDown(t *testing.T) {
+	primary := newFailoverTestServer(t, http.StatusServiceUnavailable, primaryAPIKey)
+	secondary := newFailoverTestServer(t, http.StatusServiceUnavailable, "secondary-key")
+	client := newFailoverTestClient(t, primary, time.Hour, secondary)
+
+	for range 2 {
+		if status := doFailoverRequest(t, client, http.MethodGet); status != http.StatusServiceUnavailable {
+			t.Errorf("got status %d, want 503", status)
+		}
+	}
+	if n := primary.requests.Load(); n != 2 {
+		t.Errorf("primary received %d requests, want 2", n)
+	}
+	if n := secondary.requests.Load(); n != 2 {
+		t.Errorf("secondary received %d requests, want 2", n)
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2021, 2026
+
+package api
+
+import (
+	"context"
+	"iter"
+)
+
+// DefaultPageSize is the limit requested from list endpoints by Paginate.
+const DefaultPageSize = 100
+
+// Page is one page of results from a list endpoint.
+type Page[T any] struct {
+	Items []T
+	Total int
+}
+
+// PageFunc fetches up to limit items starting at offset. Implementations wrap
+// a generated List*WithResponse call and turn unexpected statuses into errors;
+// returning an empty page ends iteration.
+type PageFunc[T any] func(ctx context.Context, limit, offset int) (Page[T], error)
+
+// Paginate iterates over every item of an offset-paginated list endpoint,
+// fetching pages lazily so callers that stop early do not read the rest.
+// Iteration stops at the first error, which is yielded with a zero item, and
+// when ctx is canceled between pages. Rate-limited responses are retried by
+// the client itself, see WithRetry.
+func Paginate[T any](ctx context.Context, fetch PageFunc[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		offset := 0
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			page, err := fetch(ctx, DefaultPageSize, offset)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range page.Items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			offset += len(page.Items)
+			if len(page.Items) < DefaultPageSize || offset >= page.Total {
+				return
+			}
+		}
+	}
+}
+
+// Collect reads every item from a Paginate iterator.
+func Collect[T any](items iter.Seq2[T, error]) ([]T, error) {
+	var result []T
+	for item, err := range items {
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, item)
+	}
+	return result, nil
+}
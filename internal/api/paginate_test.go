@@ -0,0 +1,135 @@
+// Copyright IBM Corp. 2021, 2026
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+)
+
+// newPaginateTestServer serves total items from an offset-paginated list
+// endpoint and counts the pages requested.
+func newPaginateTestServer(t *testing.T, total int, pages *atomic.Int32) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pages.Add(1)
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		items := []int{}
+		for i := offset; i < min(offset+limit, total); i++ {
+			items = append(items, i)
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"items": items, "total": total})
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func paginateTestFetch(server *httptest.Server) PageFunc[int] {
+	return func(ctx context.Context, limit, offset int) (Page[int], error) {
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/items?limit=%d&offset=%d", server.URL, limit, offset), nil)
+		resp, err := server.Client().Do(req)
+		if err != nil {
+			return Page[int]{}, err
+		}
+		defer resp.Body.Close()
+		var body struct {
+			Items []int `json:"items"`
+			Total int   `json:"total"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+			return Page[int]{}, err
+		}
+		return Page[int]{Items: body.Items, Total: body.Total}, nil
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	cases := map[string]struct {
+		total     int
+		wantPages int
+	}{
+		"empty":             {total: 0, wantPages: 1},
+		"short page":        {total: 99, wantPages: 1},
+		"exactly one page":  {total: DefaultPageSize, wantPages: 1},
+		"one past a page":   {total: DefaultPageSize + 1, wantPages: 2},
+		"exactly two pages": {total: 2 * DefaultPageSize, wantPages: 2},
+		"partial last page": {total: 2*DefaultPageSize + 50, wantPages: 3},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var pages atomic.Int32
+			server := newPaginateTestServer(t, tc.total, &pages)
+
+			items, err := Collect(Paginate(context.Background(), paginateTestFetch(server)))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(items) != tc.total {
+				t.Fatalf("got %d items, want %d", len(items), tc.total)
+			}
+			for i, item := range items {
+				if item != i {
+					t.Fatalf("item %d = %d, want items in order without gaps or repeats", i, item)
+				}
+			}
+			if n := int(pages.Load()); n != tc.wantPages {
+				t.Errorf("fetched %d pages, want %d", n, tc.wantPages)
+			}
+		})
+	}
+}
+
+func TestPaginateStopsEarly(t *testing.T) {
+	var pages atomic.Int32
+	server := newPaginateTestServer(t, 3*DefaultPageSize, &pages)
+
+	seen := 0
+	for _, err := range Paginate(context.Background(), paginateTestFetch(server)) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		seen++
+		if seen == DefaultPageSize+1 {
+			break
+		}
+	}
+	if n := pages.Load(); n != 2 {
+		t.Errorf("fetched %d pages, want 2 when stopping in the second", n)
+	}
+}
+
+func TestPaginateError(t *testing.T) {
+	want := errors.New("list failed")
+	calls := 0
+	items, err := Collect(Paginate(context.Background(), func(ctx context.Context, limit, offset int) (Page[int], error) {
+		calls++
+		if offset > 0 {
+			return Page[int]{}, want
+		}
+		return Page[int]{Items: make([]int, limit), Total: 2 * limit}, nil
+	}))
+	if !errors.Is(err, want) || items != nil {
+		t.Errorf("got %d items and error %v, want no items and %v", len(items), err, want)
+	}
+	if calls != 2 {
+		t.Errorf("fetched %d pages, want 2", calls)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := Collect(Paginate(ctx, func(ctx context.Context, limit, offset int) (Page[int], error) {
+		t.Fatal("fetched a page with a canceled context")
+		return Page[int]{}, nil
+	})); !errors.Is(err, context.Canceled) {
+		t.Errorf("got error %v, want context.Canceled", err)
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright IBM Corp. 2021, 2026
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// WithRateLimit limits the client to requestsPerSecond requests on average,
+// allowing bursts of up to burst requests. Every resource shares the client,
+// so a large parallel apply queues its requests instead of exceeding the
+// API's rate limit. It wraps the innermost HTTP client, so retries are
+// limited too. A requestsPerSecond of 0 disables it. It must be applied
+// directly after WithRetry, before anything else wraps that client.
+func WithRateLimit(requestsPerSecond, burst int) ClientOption {
+	return func(c *Client) error {
+		if requestsPerSecond < 0 {
+			return fmt.Errorf("requests per second must be non-negative, got %d", requestsPerSecond)
+		}
+		if requestsPerSecond == 0 {
+			return nil
+		}
+		if burst < 1 {
+			return fmt.Errorf("request burst must be at least 1, got %d", burst)
+		}
+		doer, ok := c.Client.(*retryingDoer)
+		if !ok {
+			return errors.New("WithRateLimit must be applied directly after WithRetry")
+		}
+		switch doer.doer.(type) {
+		case *rateLimitedDoer, *debugDoer:
+			return errors.New("WithRateLimit must be applied directly after WithRetry")
+		}
+		doer.doer = &rateLimitedDoer{
+			doer:   doer.doer,
+			rate:   float64(requestsPerSecond),
+			burst:  float64(burst),
+			tokens: float64(burst),
+			last:   time.Now(),
+		}
+		return nil
+	}
+}
+
+// rateLimitedDoer is a token bucket. Each request takes a token, going into
+// debt when none are left, and waits until the debt would be repaid, so
+// waiting requests are sent in the order they arrived.
+type rateLimitedDoer struct {
+	doer  HttpRequestDoer
+	rate  float64
+	burst float64
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func (d *rateLimitedDoer) Do(req *http.Request) (*http.Response, error) {
+	if delay := d.reserve(); delay > 0 {
+		tflog.Debug(req.Context(), "Rate limiting Ctrlplane API request", map[string]interface{}{
+			"method": req.Method,
+			"url":    req.URL.String(),
+			"delay":  delay.String(),
+		})
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			d.release()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+	return d.doer.Do(req)
+}
+
+// reserve takes a token and returns how long to wait before using it.
+func (d *rateLimitedDoer) reserve() time.Duration {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := time.Now()
+	d.tokens = min(d.burst, d.tokens+now.Sub(d.last).Seconds()*d.rate)
+	d.last = now
+	d.tokens--
+	if d.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-d.tokens / d.rate * float64(time.Second))
+}
+
+// release returns a token taken by a request that was canceled while waiting.
+func (d *rateLimitedDoer) release() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.tokens = min(d.burst, d.tokens+1)
+}
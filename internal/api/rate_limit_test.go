@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2021, 2026
+
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newRateLimitTestClient(t *testing.T, server *httptest.Server, requestsPerSecond, burst int) *Client {
+	t.Helper()
+	client := newRetryTestClient(t, server, 0)
+	if err := WithRateLimit(requestsPerSecond, burst)(client); err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func sendRateLimited(t *testing.T, ctx context.Context, client *Client, url string) error {
+	t.Helper()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	resp, err := client.Client.Do(req)
+	if err == nil {
+		resp.Body.Close()
+	}
+	return err
+}
+
+func TestRateLimitSteadyRate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	client := newRateLimitTestClient(t, server, 50, 1)
+	start := time.Now()
+	for range 6 {
+		if err := sendRateLimited(t, t.Context(), client, server.URL); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	// The first request spends the only token; each of the other five waits
+	// 20ms for the next one.
+	if elapsed, want := time.Since(start), 90*time.Millisecond; elapsed < want {
+		t.Errorf("6 requests at 50/s took %s, want at least %s", elapsed, want)
+	}
+}
+
+func TestRateLimitBurst(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+	}))
+	defer server.Close()
+
+	client := newRateLimitTestClient(t, server, 1, 3)
+	start := time.Now()
+	for range 3 {
+		if err := sendRateLimited(t, t.Context(), client, server.URL); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("burst of 3 took %s, want no waiting", elapsed)
+	}
+
+	ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+	defer cancel()
+	if err := sendRateLimited(t, ctx, client, server.URL); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("request past the burst: got %v, want it to wait for a token until the deadline", err)
+	}
+	if got := requests.Load(); got != 3 {
+		t.Errorf("server got %d requests, want 3", got)
+	}
+}
+
+func TestRateLimitCanceledWhileWaiting(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+	}))
+	defer server.Close()
+
+	client := newRateLimitTestClient(t, server, 1, 1)
+	if err := sendRateLimited(t, t.Context(), client, server.URL); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	time.AfterFunc(20*time.Millisecond, cancel)
+	start := time.Now()
+	if err := sendRateLimited(t, ctx, client, server.URL); !errors.Is(err, context.Canceled) {
+		t.Fatalf("got %v, want context.Canceled", err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("canceled request returned after %s, want it to stop waiting promptly", elapsed)
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("server got %d requests, want the canceled one not sent", got)
+	}
+
+	// The canceled request returns its token, so the next one waits for the
+	// rest of the first interval only, not for the canceled request's slot.
+	limiter := client.Client.(*retryingDoer).doer.(*rateLimitedDoer)
+	if delay := limiter.reserve(); delay > time.Second {
+		t.Errorf("next request waits %s, want at most 1s", delay)
+	}
+}
+
+func TestWithRateLimitOrder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	cases := map[string]func(*Client) error{
+		"without WithRetry": func(c *Client) error {
+			c.Client = server.Client()
+			return nil
+		},
+		"after WithDebugHTTP": WithDebugHTTP(),
+		"applied twice":       WithRateLimit(1, 1),
+	}
+
+	for name, before := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := newRetryTestClient(t, server, 0)
+			if err := before(client); err != nil {
+				t.Fatal(err)
+			}
+			if err := WithRateLimit(1, 1)(client); err == nil {
+				t.Error("expected an error applying WithRateLimit")
+			}
+		})
+	}
+}
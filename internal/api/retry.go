@@ -0,0 +1,163 @@
+// Copyright IBM Corp. 2021, 2026
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	DefaultMaxRetries    = 3
+	DefaultRetryMinDelay = 500 * time.Millisecond
+	DefaultRetryMaxDelay = 8 * time.Second
+)
+
+// NonIdempotentRequestError is returned when a request that is not safe to
+// repeat (such as a POST create) fails without a response. The server may or
+// may not have applied it, so it is never retried automatically.
+type NonIdempotentRequestError struct {
+	Method string
+	URL    string
+	Err    error
+}
+
+func (e *NonIdempotentRequestError) Error() string {
+	return fmt.Sprintf(
+		"%s %s failed before a response was received: %s. The request may still have been applied; "+
+			"refresh state or import the object before retrying to avoid creating a duplicate",
+		e.Method, e.URL, e.Err,
+	)
+}
+
+func (e *NonIdempotentRequestError) Unwrap() error {
+	return e.Err
+}
+
+// retryingDoer retries transport errors and transient status codes, but only
+// for idempotent requests: GET/HEAD/OPTIONS reads and PUT/DELETE, which the
+// API uses for upserts and deletes keyed by a client-supplied ID.
+type retryingDoer struct {
+	doer       HttpRequestDoer
+	maxRetries int
+	minDelay   time.Duration
+	maxDelay   time.Duration
+}
+
+func newRetryingDoer(doer HttpRequestDoer) *retryingDoer {
+	return &retryingDoer{
+		doer:       doer,
+		maxRetries: DefaultMaxRetries,
+		minDelay:   DefaultRetryMinDelay,
+		maxDelay:   DefaultRetryMaxDelay,
+	}
+}
+
+// WithRetry overrides the retry policy of a client built by
+// NewAPIKeyClientWithResponses. Delays start at minDelay and double after
+// each attempt up to maxDelay. A maxRetries of 0 disables retries. It must be
+// applied before options that wrap the HTTP client, such as WithDryRun.
+func WithRetry(maxRetries int, minDelay, maxDelay time.Duration) ClientOption {
+	return func(c *Client) error {
+		doer, ok := c.Client.(*retryingDoer)
+		if !ok {
+			return errors.New("WithRetry must be applied before other HTTP client options")
+		}
+		if maxRetries < 0 {
+			return fmt.Errorf("max retries must be non-negative, got %d", maxRetries)
+		}
+		if minDelay <= 0 || maxDelay < minDelay {
+			return fmt.Errorf("retry delays must satisfy 0 < min (%s) <= max (%s)", minDelay, maxDelay)
+		}
+		doer.maxRetries = maxRetries
+		doer.minDelay = minDelay
+		doer.maxDelay = maxDelay
+		return nil
+	}
+}
+
+func (d *retryingDoer) Do(req *http.Request) (*http.Response, error) {
+	if !isIdempotentRequest(req) {
+		resp, err := d.doer.Do(req)
+		if err != nil {
+			return nil, &NonIdempotentRequestError{Method: req.Method, URL: req.URL.String(), Err: err}
+		}
+		return resp, nil
+	}
+
+	delay := d.minDelay
+	for attempt := 0; ; attempt++ {
+		resp, err := d.doer.Do(req)
+		if attempt >= d.maxRetries || !shouldRetry(resp, err) {
+			return resp, err
+		}
+
+		if req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				return resp, err
+			}
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return resp, err
+			}
+			req.Body = body
+		}
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(retryAfter(resp, delay, d.maxDelay))
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+		delay = min(delay*2, d.maxDelay)
+	}
+}
+
+func isIdempotentRequest(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+func shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// retryAfter returns how long to wait before retrying resp. A 429 or 503 with
+// a Retry-After header, in seconds or as an HTTP date, waits as long as the
+// server asks, up to maxDelay. Otherwise the backoff delay applies.
+func retryAfter(resp *http.Response, delay, maxDelay time.Duration) time.Duration {
+	if resp == nil || (resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusServiceUnavailable) {
+		return delay
+	}
+
+	header := resp.Header.Get("Retry-After")
+	if header == "" {
+		return delay
+	}
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return min(time.Duration(seconds)*time.Second, maxDelay)
+	}
+	if at, err := http.ParseTime(header); err == nil {
+		return min(max(time.Until(at), 0), maxDelay)
+	}
+	return delay
+}
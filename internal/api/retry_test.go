@@ -0,0 +1,189 @@
+// Copyright IBM Corp. 2021, 2026
+
+package api
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newRetryTestClient(t *testing.T, server *httptest.Server, maxRetries int) *Client {
+	t.Helper()
+	client := &Client{Server: server.URL, Client: newRetryingDoer(server.Client())}
+	if err := WithRetry(maxRetries, time.Millisecond, 2*time.Millisecond)(client); err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestRetryingDoer(t *testing.T) {
+	cases := map[string]struct {
+		method       string
+		statuses     []int
+		maxRetries   int
+		wantAttempts int32
+		wantStatus   int
+	}{
+		"get recovers":      {method: http.MethodGet, statuses: []int{503, 502, 200}, maxRetries: 3, wantAttempts: 3, wantStatus: 200},
+		"get gives up":      {method: http.MethodGet, statuses: []int{429}, maxRetries: 2, wantAttempts: 3, wantStatus: 429},
+		"put recovers":      {method: http.MethodPut, statuses: []int{504, 202}, maxRetries: 3, wantAttempts: 2, wantStatus: 202},
+		"delete recovers":   {method: http.MethodDelete, statuses: []int{503, 204}, maxRetries: 3, wantAttempts: 2, wantStatus: 204},
+		"post not retried":  {method: http.MethodPost, statuses: []int{503, 201}, maxRetries: 3, wantAttempts: 1, wantStatus: 503},
+		"patch not retried": {method: http.MethodPatch, statuses: []int{503, 200}, maxRetries: 3, wantAttempts: 1, wantStatus: 503},
+		"client error":      {method: http.MethodGet, statuses: []int{400, 200}, maxRetries: 3, wantAttempts: 1, wantStatus: 400},
+		"server error":      {method: http.MethodGet, statuses: []int{500, 200}, maxRetries: 3, wantAttempts: 2, wantStatus: 200},
+		"post server error": {method: http.MethodPost, statuses: []int{500, 201}, maxRetries: 3, wantAttempts: 1, wantStatus: 500},
+		"not implemented":   {method: http.MethodGet, statuses: []int{501, 200}, maxRetries: 3, wantAttempts: 1, wantStatus: 501},
+		"retries disabled":  {method: http.MethodGet, statuses: []int{503, 200}, maxRetries: 0, wantAttempts: 1, wantStatus: 503},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := int(attempts.Add(1)) - 1
+				w.WriteHeader(tc.statuses[min(n, len(tc.statuses)-1)])
+			}))
+			defer server.Close()
+
+			client := newRetryTestClient(t, server, tc.maxRetries)
+			req, _ := http.NewRequest(tc.method, server.URL+"/v1/systems", nil)
+			resp, err := client.Client.Do(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tc.wantStatus {
+				t.Errorf("got status %d, want %d", resp.StatusCode, tc.wantStatus)
+			}
+			if n := attempts.Load(); n != tc.wantAttempts {
+				t.Errorf("got %d attempts, want %d", n, tc.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestRetryingDoerReplaysBody(t *testing.T) {
+	const body = `{"name":"web"}`
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got, _ := io.ReadAll(r.Body)
+		if string(got) != body {
+			t.Errorf("attempt %d: got body %q, want %q", attempts.Load()+1, got, body)
+		}
+		if attempts.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	client := newRetryTestClient(t, server, 3)
+	req, _ := http.NewRequest(http.MethodPut, server.URL+"/v1/systems/1", strings.NewReader(body))
+	resp, err := client.Client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusAccepted || attempts.Load() != 2 {
+		t.Errorf("got status %d after %d attempts, want 202 after 2", resp.StatusCode, attempts.Load())
+	}
+}
+
+func TestRetryingDoerHonorsRetryAfter(t *testing.T) {
+	var attempts atomic.Int32
+	var first time.Time
+	var waited time.Duration
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			first = time.Now()
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		waited = time.Since(first)
+	}))
+	defer server.Close()
+
+	client := &Client{Server: server.URL, Client: newRetryingDoer(server.Client())}
+	if err := WithRetry(1, time.Millisecond, 2*time.Second)(client); err != nil {
+		t.Fatal(err)
+	}
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/v1/systems", nil)
+	resp, err := client.Client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if waited < time.Second {
+		t.Errorf("retried after %s, want at least the 1s Retry-After", waited)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	const delay, maxDelay = time.Second, 10 * time.Second
+
+	cases := map[string]struct {
+		status int
+		header string
+		want   time.Duration
+	}{
+		"seconds":           {status: http.StatusTooManyRequests, header: "3", want: 3 * time.Second},
+		"capped":            {status: http.StatusServiceUnavailable, header: "120", want: maxDelay},
+		"http date in past": {status: http.StatusServiceUnavailable, header: "Mon, 02 Jan 2006 15:04:05 GMT", want: 0},
+		"http date capped":  {status: http.StatusTooManyRequests, header: time.Now().Add(time.Hour).UTC().Format(http.TimeFormat), want: maxDelay},
+		"no header":         {status: http.StatusTooManyRequests, want: delay},
+		"invalid header":    {status: http.StatusTooManyRequests, header: "soon", want: delay},
+		"ignored on 502":    {status: http.StatusBadGateway, header: "3", want: delay},
+		"ignored on 500":    {status: http.StatusInternalServerError, header: "3", want: delay},
+		"negative seconds":  {status: http.StatusTooManyRequests, header: "-1", want: delay},
+		"zero seconds":      {status: http.StatusServiceUnavailable, header: "0", want: 0},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			resp := &http.Response{StatusCode: tc.status, Header: http.Header{}}
+			if tc.header != "" {
+				resp.Header.Set("Retry-After", tc.header)
+			}
+			if got := retryAfter(resp, delay, maxDelay); got != tc.want {
+				t.Errorf("got %s, want %s", got, tc.want)
+			}
+		})
+	}
+
+	if got := retryAfter(nil, delay, maxDelay); got != delay {
+		t.Errorf("transport error: got %s, want %s", got, delay)
+	}
+}
+
+func TestRetryingDoerNonIdempotentTransportError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	client := newRetryTestClient(t, server, 3)
+	server.Close()
+
+	req, _ := http.NewRequest(http.MethodPost, server.URL+"/v1/systems", strings.NewReader(`{}`))
+	_, err := client.Client.Do(req)
+	var nonIdempotent *NonIdempotentRequestError
+	if !errors.As(err, &nonIdempotent) {
+		t.Fatalf("got error %v, want *NonIdempotentRequestError", err)
+	}
+	if nonIdempotent.Method != http.MethodPost {
+		t.Errorf("Method = %q, want POST", nonIdempotent.Method)
+	}
+}
+
+func TestWithRetryOrder(t *testing.T) {
+	client := &Client{Client: &http.Client{}}
+	if err := WithRetry(1, time.Millisecond, time.Millisecond)(client); err == nil {
+		t.Error("expected an error applying WithRetry to a client without a retrying doer")
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2021, 2026
+
+package api
+
+import (
+	"context"
+	"io"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// WorkspaceAPI is the part of the generated client that the provider calls.
+// Resources and data sources depend on it rather than on ClientWithResponses,
+// so a regenerated client only has to keep these methods. When a spec refresh
+// renames an operation or its types, add a method with the old name to
+// compat.go that calls the new one, and a type alias for each renamed type,
+// instead of editing every call site in the same change. Add a method here
+// when the provider starts calling a new operation.
+type WorkspaceAPI interface {
+	CreateDeploymentVersionWithResponse(ctx context.Context, workspaceId string, deploymentId string, body CreateDeploymentVersionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDeploymentVersionResponse, error)
+	CreateRelationshipRuleWithResponse(ctx context.Context, workspaceId string, body CreateRelationshipRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRelationshipRuleResponse, error)
+	CreateVariableSetWithResponse(ctx context.Context, workspaceId string, body CreateVariableSetJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateVariableSetResponse, error)
+	CreateWorkflowWithResponse(ctx context.Context, workspaceId string, body CreateWorkflowJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWorkflowResponse, error)
+	DeleteRelationshipWithResponse(ctx context.Context, workspaceId string, relationshipRuleId string, reqEditors ...RequestEditorFn) (*DeleteRelationshipResponse, error)
+	DeleteVariableSetWithResponse(ctx context.Context, workspaceId string, variableSetId string, reqEditors ...RequestEditorFn) (*DeleteVariableSetResponse, error)
+	DeleteWorkflowWithResponse(ctx context.Context, workspaceId string, workflowId string, reqEditors ...RequestEditorFn) (*DeleteWorkflowResponse, error)
+	GetAllResourcesWithResponse(ctx context.Context, workspaceId string, params *GetAllResourcesParams, reqEditors ...RequestEditorFn) (*GetAllResourcesResponse, error)
+	GetDeploymentByNameWithResponse(ctx context.Context, workspaceId string, name string, reqEditors ...RequestEditorFn) (*GetDeploymentByNameResponse, error)
+	GetDeploymentSystemLinkWithResponse(ctx context.Context, workspaceId string, systemId string, deploymentId string, reqEditors ...RequestEditorFn) (*GetDeploymentSystemLinkResponse, error)
+	GetDeploymentVariableValueWithResponse(ctx context.Context, workspaceId string, valueId string, reqEditors ...RequestEditorFn) (*GetDeploymentVariableValueResponse, error)
+	GetDeploymentVariableWithResponse(ctx context.Context, workspaceId string, variableId string, reqEditors ...RequestEditorFn) (*GetDeploymentVariableResponse, error)
+	GetDeploymentWithResponse(ctx context.Context, workspaceId string, deploymentId string, reqEditors ...RequestEditorFn) (*GetDeploymentResponse, error)
+	GetEnvironmentByNameWithResponse(ctx context.Context, workspaceId string, name string, reqEditors ...RequestEditorFn) (*GetEnvironmentByNameResponse, error)
+	GetEnvironmentSystemLinkWithResponse(ctx context.Context, workspaceId string, systemId string, environmentId string, reqEditors ...RequestEditorFn) (*GetEnvironmentSystemLinkResponse, error)
+	GetEnvironmentWithResponse(ctx context.Context, workspaceId string, environmentId string, reqEditors ...RequestEditorFn) (*GetEnvironmentResponse, error)
+	GetJobAgentWithResponse(ctx context.Context, workspaceId string, jobAgentId string, reqEditors ...RequestEditorFn) (*GetJobAgentResponse, error)
+	GetPolicyWithResponse(ctx context.Context, workspaceId string, policyId string, reqEditors ...RequestEditorFn) (*GetPolicyResponse, error)
+	GetRelationshipRuleWithResponse(ctx context.Context, workspaceId string, relationshipRuleId string, reqEditors ...RequestEditorFn) (*GetRelationshipRuleResponse, error)
+	GetResourceByIdentifierWithResponse(ctx context.Context, workspaceId string, identifier string, reqEditors ...RequestEditorFn) (*GetResourceByIdentifierResponse, error)
+	GetResourceProviderByNameWithResponse(ctx context.Context, workspaceId string, name string, reqEditors ...RequestEditorFn) (*GetResourceProviderByNameResponse, error)
+	GetResourceProviderResourcesWithResponse(ctx context.Context, workspaceId string, name string, reqEditors ...RequestEditorFn) (*GetResourceProviderResourcesResponse, error)
+	GetSystemWithResponse(ctx context.Context, workspaceId string, systemId string, reqEditors ...RequestEditorFn) (*GetSystemResponse, error)
+	GetVariableSetWithResponse(ctx context.Context, workspaceId string, variableSetId string, reqEditors ...RequestEditorFn) (*GetVariableSetResponse, error)
+	GetWorkflowWithResponse(ctx context.Context, workspaceId string, workflowId string, reqEditors ...RequestEditorFn) (*GetWorkflowResponse, error)
+	GetWorkspaceWithResponse(ctx context.Context, workspaceId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetWorkspaceResponse, error)
+	LinkDeploymentToSystemWithResponse(ctx context.Context, workspaceId string, systemId string, deploymentId string, reqEditors ...RequestEditorFn) (*LinkDeploymentToSystemResponse, error)
+	LinkEnvironmentToSystemWithResponse(ctx context.Context, workspaceId string, systemId string, environmentId string, reqEditors ...RequestEditorFn) (*LinkEnvironmentToSystemResponse, error)
+	ListDeploymentVersionsWithResponse(ctx context.Context, workspaceId string, deploymentId string, params *ListDeploymentVersionsParams, reqEditors ...RequestEditorFn) (*ListDeploymentVersionsResponse, error)
+	ListDeploymentsWithResponse(ctx context.Context, workspaceId string, params *ListDeploymentsParams, reqEditors ...RequestEditorFn) (*ListDeploymentsResponse, error)
+	ListEnvironmentsWithResponse(ctx context.Context, workspaceId string, params *ListEnvironmentsParams, reqEditors ...RequestEditorFn) (*ListEnvironmentsResponse, error)
+	ListJobAgentsWithResponse(ctx context.Context, workspaceId string, params *ListJobAgentsParams, reqEditors ...RequestEditorFn) (*ListJobAgentsResponse, error)
+	ListPoliciesWithResponse(ctx context.Context, workspaceId string, params *ListPoliciesParams, reqEditors ...RequestEditorFn) (*ListPoliciesResponse, error)
+	ListSystemsWithResponse(ctx context.Context, workspaceId string, params *ListSystemsParams, reqEditors ...RequestEditorFn) (*ListSystemsResponse, error)
+	RequestDeploymentCreationWithResponse(ctx context.Context, workspaceId string, body RequestDeploymentCreationJSONRequestBody, reqEditors ...RequestEditorFn) (*RequestDeploymentCreationResponse, error)
+	RequestDeploymentDeletionWithResponse(ctx context.Context, workspaceId string, deploymentId string, reqEditors ...RequestEditorFn) (*RequestDeploymentDeletionResponse, error)
+	RequestDeploymentUpsertWithResponse(ctx context.Context, workspaceId string, deploymentId string, body RequestDeploymentUpsertJSONRequestBody, reqEditors ...RequestEditorFn) (*RequestDeploymentUpsertResponse, error)
+	RequestDeploymentVariableDeletionWithResponse(ctx context.Context, workspaceId string, variableId string, reqEditors ...RequestEditorFn) (*RequestDeploymentVariableDeletionResponse, error)
+	RequestDeploymentVariableUpdateWithResponse(ctx context.Context, workspaceId string, variableId string, body RequestDeploymentVariableUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*RequestDeploymentVariableUpdateResponse, error)
+	RequestDeploymentVariableValueDeletionWithResponse(ctx context.Context, workspaceId string, valueId string, reqEditors ...RequestEditorFn) (*RequestDeploymentVariableValueDeletionResponse, error)
+	RequestDeploymentVariableValueUpsertWithResponse(ctx context.Context, workspaceId string, valueId string, body RequestDeploymentVariableValueUpsertJSONRequestBody, reqEditors ...RequestEditorFn) (*RequestDeploymentVariableValueUpsertResponse, error)
+	RequestDeploymentVersionUpdateWithResponse(ctx context.Context, workspaceId string, deploymentVersionId string, body RequestDeploymentVersionUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*RequestDeploymentVersionUpdateResponse, error)
+	RequestEnvironmentCreationWithResponse(ctx context.Context, workspaceId string, body RequestEnvironmentCreationJSONRequestBody, reqEditors ...RequestEditorFn) (*RequestEnvironmentCreationResponse, error)
+	RequestEnvironmentDeletionWithResponse(ctx context.Context, workspaceId string, environmentId string, reqEditors ...RequestEditorFn) (*RequestEnvironmentDeletionResponse, error)
+	RequestEnvironmentUpsertWithResponse(ctx context.Context, workspaceId string, environmentId string, body RequestEnvironmentUpsertJSONRequestBody, reqEditors ...RequestEditorFn) (*RequestEnvironmentUpsertResponse, error)
+	RequestJobAgentDeletionWithResponse(ctx context.Context, workspaceId string, jobAgentId string, reqEditors ...RequestEditorFn) (*RequestJobAgentDeletionResponse, error)
+	RequestJobAgentUpsertWithResponse(ctx context.Context, workspaceId string, jobAgentId string, body RequestJobAgentUpsertJSONRequestBody, reqEditors ...RequestEditorFn) (*RequestJobAgentUpsertResponse, error)
+	RequestPolicyCreationWithBodyWithResponse(ctx context.Context, workspaceId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RequestPolicyCreationResponse, error)
+	RequestPolicyDeletionWithResponse(ctx context.Context, workspaceId string, policyId string, reqEditors ...RequestEditorFn) (*RequestPolicyDeletionResponse, error)
+	RequestPolicyUpsertWithBodyWithResponse(ctx context.Context, workspaceId string, policyId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RequestPolicyUpsertResponse, error)
+	RequestRelationshipRuleUpsertWithResponse(ctx context.Context, workspaceId string, relationshipRuleId string, body RequestRelationshipRuleUpsertJSONRequestBody, reqEditors ...RequestEditorFn) (*RequestRelationshipRuleUpsertResponse, error)
+	RequestResourceDeletionByIdentifierWithResponse(ctx context.Context, workspaceId string, identifier string, reqEditors ...RequestEditorFn) (*RequestResourceDeletionByIdentifierResponse, error)
+	RequestResourceProviderUpsertWithResponse(ctx context.Context, workspaceId string, body RequestResourceProviderUpsertJSONRequestBody, reqEditors ...RequestEditorFn) (*RequestResourceProviderUpsertResponse, error)
+	RequestSystemCreationWithResponse(ctx context.Context, workspaceId string, body RequestSystemCreationJSONRequestBody, reqEditors ...RequestEditorFn) (*RequestSystemCreationResponse, error)
+	RequestSystemDeletionWithResponse(ctx context.Context, workspaceId string, systemId string, reqEditors ...RequestEditorFn) (*RequestSystemDeletionResponse, error)
+	RequestSystemUpsertWithResponse(ctx context.Context, workspaceId string, systemId string, body RequestSystemUpsertJSONRequestBody, reqEditors ...RequestEditorFn) (*RequestSystemUpsertResponse, error)
+	SetResourceProviderResourcesWithResponse(ctx context.Context, workspaceId string, providerId string, body SetResourceProviderResourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*SetResourceProviderResourcesResponse, error)
+	UnlinkDeploymentFromSystemWithResponse(ctx context.Context, workspaceId string, systemId string, deploymentId string, reqEditors ...RequestEditorFn) (*UnlinkDeploymentFromSystemResponse, error)
+	UnlinkEnvironmentFromSystemWithResponse(ctx context.Context, workspaceId string, systemId string, environmentId string, reqEditors ...RequestEditorFn) (*UnlinkEnvironmentFromSystemResponse, error)
+	UpdateVariableSetWithResponse(ctx context.Context, workspaceId string, variableSetId string, body UpdateVariableSetJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateVariableSetResponse, error)
+	UpdateWorkflowWithResponse(ctx context.Context, workspaceId string, workflowId string, body UpdateWorkflowJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWorkflowResponse, error)
+}
+
+var _ WorkspaceAPI = (*ClientWithResponses)(nil)
@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2021, 2026
+
+package provider
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// credentialsFile is the path of the shared credentials file, relative to the
+// user's home directory.
+const credentialsFile = ".ctrlplane/credentials"
+
+// parseCredentials reads a credentials file: one key = value pair per line,
+// with blank lines and lines starting with # or ; ignored. Values may be
+// wrapped in double quotes. Keys the provider does not use are kept, so the
+// file can be shared with other tools.
+func parseCredentials(r io.Reader) (map[string]string, error) {
+	values := map[string]string{}
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") || strings.HasPrefix(text, ";") {
+			continue
+		}
+
+		key, value, ok := strings.Cut(text, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("line %d: expected key = value", line)
+		}
+		value = strings.TrimSpace(value)
+		if len(value) >= 2 && strings.HasPrefix(value, `"`) && strings.HasSuffix(value, `"`) {
+			value = value[1 : len(value)-1]
+		}
+		values[key] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// readAPIKeyFile returns the API key stored in path, which holds only the key.
+// Surrounding whitespace, such as a trailing newline, is ignored.
+func readAPIKeyFile(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	apiKey := strings.TrimSpace(string(content))
+	if apiKey == "" {
+		return "", fmt.Errorf("%s is empty", path)
+	}
+	return apiKey, nil
+}
+
+// credentialsFileAPIKey returns the api_key entry of the shared credentials
+// file and the file's path. It returns "" without an error when there is no
+// home directory or credentials file, or the file has no api_key entry.
+func credentialsFileAPIKey() (string, string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", "", nil
+	}
+	path := filepath.Join(home, credentialsFile)
+
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", path, nil
+	}
+	if err != nil {
+		return "", path, err
+	}
+	defer file.Close()
+
+	values, err := parseCredentials(file)
+	if err != nil {
+		return "", path, err
+	}
+	return values["api_key"], path, nil
+}
@@ -0,0 +1,38 @@
+// Copyright IBM Corp. 2021, 2026
+
+package provider
+
+import (
+	"maps"
+	"strings"
+	"testing"
+)
+
+func TestParseCredentials(t *testing.T) {
+	values, err := parseCredentials(strings.NewReader(`
+# Ctrlplane credentials
+api_key = abc123
+; written by another tool
+url="https://ctrlplane.example.com"
+empty =
+`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := map[string]string{
+		"api_key": "abc123",
+		"url":     "https://ctrlplane.example.com",
+		"empty":   "",
+	}
+	if !maps.Equal(values, want) {
+		t.Errorf("got %v, want %v", values, want)
+	}
+}
+
+func TestParseCredentials_invalidLine(t *testing.T) {
+	_, err := parseCredentials(strings.NewReader("api_key = abc123\nnot a pair\n"))
+	if err == nil || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("expected an error for line 2, got %v", err)
+	}
+}
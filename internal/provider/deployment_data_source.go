@@ -5,6 +5,7 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 
@@ -16,6 +17,7 @@ import (
 
 var _ datasource.DataSource = &DeploymentDataSource{}
 var _ datasource.DataSourceWithConfigure = &DeploymentDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &DeploymentDataSource{}
 
 func NewDeploymentDataSource() datasource.DataSource {
 	return &DeploymentDataSource{}
@@ -32,7 +34,10 @@ type DeploymentDataSourceModel struct {
 	Description      types.String `tfsdk:"description"`
 	ResourceSelector types.String `tfsdk:"resource_selector"`
 	JobAgentSelector types.String `tfsdk:"job_agent_selector"`
+	JobAgentConfig   types.String `tfsdk:"job_agent_config"`
 	Metadata         types.Map    `tfsdk:"metadata"`
+	AppURL           types.String `tfsdk:"app_url"`
+	EntityURL        types.String `tfsdk:"entity_url"`
 }
 
 func (d *DeploymentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,19 +46,29 @@ func (d *DeploymentDataSource) Metadata(ctx context.Context, req datasource.Meta
 
 func (d *DeploymentDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Fetch an existing deployment by name within the configured workspace.",
+		Description: "Fetch an existing deployment by name or slug within the configured workspace.",
 		Attributes: map[string]schema.Attribute{
+			"app_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "Link to the workspace in the Ctrlplane UI",
+			},
+			"entity_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "Link to this deployment in the Ctrlplane UI",
+			},
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "The ID of the deployment",
 			},
 			"name": schema.StringAttribute{
-				Required:    true,
-				Description: "The name of the deployment to look up",
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the deployment to look up. Exactly one of name or slug must be set.",
 			},
 			"slug": schema.StringAttribute{
+				Optional:    true,
 				Computed:    true,
-				Description: "The slug of the deployment",
+				Description: "The slug of the deployment to look up. Exactly one of name or slug must be set.",
 			},
 			"description": schema.StringAttribute{
 				Computed:    true,
@@ -67,6 +82,11 @@ func (d *DeploymentDataSource) Schema(ctx context.Context, req datasource.Schema
 				Computed:    true,
 				Description: "CEL expression used to match a job agent",
 			},
+			"job_agent_config": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The job agent configuration of the deployment, JSON encoded",
+			},
 			"metadata": schema.MapAttribute{
 				Computed:    true,
 				Description: "The metadata of the deployment",
@@ -90,43 +110,92 @@ func (d *DeploymentDataSource) Configure(ctx context.Context, req datasource.Con
 	d.workspace = workspace
 }
 
-func (d *DeploymentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+func (d *DeploymentDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
 	var data DeploymentDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	depResp, err := d.workspace.Client.GetDeploymentByNameWithResponse(
-		ctx, d.workspace.ID.String(), data.Name.ValueString(),
-	)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to read deployment",
-			fmt.Sprintf("Failed to read deployment with name '%s': %s", data.Name.ValueString(), err.Error()),
-		)
+	if data.Name.IsUnknown() || data.Slug.IsUnknown() {
 		return
 	}
 
-	if depResp.StatusCode() == http.StatusNotFound {
-		resp.Diagnostics.AddError(
-			"Deployment not found",
-			fmt.Sprintf("No deployment with name '%s' in workspace '%s'", data.Name.ValueString(), d.workspace.ID.String()),
-		)
+	if data.Name.IsNull() == data.Slug.IsNull() {
+		resp.Diagnostics.AddError("Invalid deployment lookup", "Exactly one of name or slug must be set.")
+	}
+}
+
+func (d *DeploymentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DeploymentDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if depResp.StatusCode() != http.StatusOK || depResp.JSON200 == nil {
-		resp.Diagnostics.AddError("Failed to read deployment", formatResponseError(depResp.StatusCode(), depResp.Body))
+	var dep api.Deployment
+	if !data.Name.IsNull() {
+		depResp, err := d.workspace.Client.GetDeploymentByNameWithResponse(
+			ctx, d.workspace.ID.String(), data.Name.ValueString(),
+		)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to read deployment",
+				fmt.Sprintf("Failed to read deployment with name '%s': %s", data.Name.ValueString(), err.Error()),
+			)
+			return
+		}
+
+		if depResp.StatusCode() == http.StatusNotFound {
+			resp.Diagnostics.AddError(
+				"Deployment not found",
+				fmt.Sprintf("No deployment with name '%s' in workspace '%s'", data.Name.ValueString(), d.workspace.ID.String()),
+			)
+			return
+		}
+
+		if depResp.StatusCode() != http.StatusOK || depResp.JSON200 == nil {
+			resp.Diagnostics.AddError("Failed to read deployment", formatResponseError(depResp.HTTPResponse, depResp.Body))
+			return
+		}
+		dep = depResp.JSON200.Deployment
+	} else {
+		slug := data.Slug.ValueString()
+		deployments, err := listDeployments(ctx, d.workspace, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read deployment", err.Error())
+			return
+		}
+
+		found := false
+		for _, item := range deployments {
+			if item.Deployment.Slug == slug {
+				dep = item.Deployment
+				found = true
+				break
+			}
+		}
+		if !found {
+			resp.Diagnostics.AddError(
+				"Deployment not found",
+				fmt.Sprintf("No deployment with slug '%s' in workspace '%s'", slug, d.workspace.ID.String()),
+			)
+			return
+		}
+	}
+
+	jobAgentConfig, err := jobAgentConfigValue(dep.JobAgentConfig)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read deployment", err.Error())
 		return
 	}
 
-	dep := depResp.JSON200.Deployment
 	data.ID = types.StringValue(dep.Id)
 	data.Name = types.StringValue(dep.Name)
 	data.Slug = types.StringValue(dep.Slug)
 	data.Description = descriptionValue(dep.Description)
 	data.Metadata = stringMapValue(dep.Metadata)
+	data.JobAgentConfig = jobAgentConfig
 	if dep.ResourceSelector != nil && *dep.ResourceSelector != "" {
 		data.ResourceSelector = types.StringValue(*dep.ResourceSelector)
 	} else {
@@ -138,5 +207,37 @@ func (d *DeploymentDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		data.JobAgentSelector = types.StringNull()
 	}
 
+	data.AppURL, data.EntityURL = entityURLs(d.workspace, "deployments", data.ID)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// listDeployments returns every deployment in the workspace, optionally
+// filtered server-side by a CEL expression.
+func listDeployments(ctx context.Context, workspace *api.WorkspaceClient, cel *string) ([]api.DeploymentAndSystems, error) {
+	return api.Collect(api.Paginate(ctx, func(ctx context.Context, limit, offset int) (api.Page[api.DeploymentAndSystems], error) {
+		listResp, err := workspace.Client.ListDeploymentsWithResponse(ctx, workspace.ID.String(), &api.ListDeploymentsParams{
+			Limit:  &limit,
+			Offset: &offset,
+			Cel:    cel,
+		})
+		if err != nil {
+			return api.Page[api.DeploymentAndSystems]{}, fmt.Errorf("failed to list deployments: %w", err)
+		}
+		if listResp.StatusCode() != http.StatusOK || listResp.JSON200 == nil {
+			return api.Page[api.DeploymentAndSystems]{}, fmt.Errorf("%s", formatResponseError(listResp.HTTPResponse, listResp.Body))
+		}
+		return api.Page[api.DeploymentAndSystems]{Items: listResp.JSON200.Items, Total: listResp.JSON200.Total}, nil
+	}))
+}
+
+func jobAgentConfigValue(config map[string]interface{}) (types.String, error) {
+	if len(config) == 0 {
+		return types.StringNull(), nil
+	}
+	encoded, err := json.Marshal(config)
+	if err != nil {
+		return types.StringNull(), fmt.Errorf("failed to encode job agent config: %w", err)
+	}
+	return types.StringValue(string(encoded)), nil
+}
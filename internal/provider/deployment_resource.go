@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"regexp"
 
 	"github.com/ctrlplanedev/terraform-provider-ctrlplane/internal/api"
 	"github.com/gosimple/slug"
@@ -14,8 +15,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -25,6 +24,7 @@ var (
 	_ resource.Resource                   = &DeploymentResource{}
 	_ resource.ResourceWithImportState    = &DeploymentResource{}
 	_ resource.ResourceWithConfigure      = &DeploymentResource{}
+	_ resource.ResourceWithModifyPlan     = &DeploymentResource{}
 	_ resource.ResourceWithValidateConfig = &DeploymentResource{}
 )
 
@@ -40,8 +40,13 @@ func (r *DeploymentResource) Metadata(ctx context.Context, req resource.Metadata
 	resp.TypeName = req.ProviderTypeName + "_deployment"
 }
 
+func (r *DeploymentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(planUnsetMetadata(ctx, r.workspace, req, &resp.Plan)...)
+	recordPlannedChange(ctx, r.workspace, "ctrlplane_deployment", req, resp)
+}
+
 func (r *DeploymentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByNaturalKey(ctx, r.workspace, req, resp, resolveDeploymentSlug(r.workspace))
 }
 
 func (r *DeploymentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -68,33 +73,53 @@ func (r *DeploymentResource) Schema(ctx context.Context, req resource.SchemaRequ
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"app_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "Link to the workspace in the Ctrlplane UI",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"entity_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "Link to this deployment in the Ctrlplane UI",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"name": schema.StringAttribute{
+				CustomType:  TrimmedStringType{},
 				Required:    true,
 				Description: "The name of the deployment",
 			},
-			"metadata": schema.MapAttribute{
-				Optional:    true,
-				Computed:    true,
-				Description: "The metadata of the deployment",
-				ElementType: types.StringType,
-				Default: func() defaults.Map {
-					empty, _ := types.MapValueFrom(context.Background(), types.StringType, map[string]string{})
-					return mapdefault.StaticValue(empty)
-				}(),
-			},
+			"metadata": metadataAttribute("The metadata of the deployment"),
 			"resource_selector": schema.StringAttribute{
 				Optional:    true,
 				Description: "CEL expression used to select resources",
+				Validators:  celValidators(),
 				PlanModifiers: []planmodifier.String{
 					celNormalized(),
 				},
 			},
+			"resource_selector_canonical": schema.StringAttribute{
+				Computed:    true,
+				Description: "The resource selector as stored by the server, which may differ textually from the configured expression",
+				PlanModifiers: []planmodifier.String{
+					selectorCanonical(path.Root("resource_selector")),
+				},
+			},
 			"job_agent_selector": schema.StringAttribute{
 				Optional:    true,
-				Description: "CEL expression to match job agents",
+				Description: "CEL expression to match job agents. Conflicts with job_agent_id",
+				Validators:  celValidators(),
+			},
+			"job_agent_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the job agent to run this deployment's jobs, as a shorthand for a job_agent_selector matching only that agent. Referencing a ctrlplane_job_agent's id creates the agent first. Conflicts with job_agent_selector",
 			},
 		},
 		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
 			"argocd": schema.SingleNestedBlock{
 				Description: "ArgoCD job agent configuration",
 				Attributes: map[string]schema.Attribute{
@@ -119,11 +144,20 @@ func (r *DeploymentResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Attributes: map[string]schema.Attribute{
 					"installation_id": schema.Int64Attribute{Optional: true, Description: "GitHub app installation ID"},
 					"owner":           schema.StringAttribute{Optional: true, Description: "GitHub repository owner"},
-					"ref":             schema.StringAttribute{Optional: true, Description: "Git ref to run the workflow on"},
+					"ref":             schema.StringAttribute{Optional: true, Description: "Git ref to run the workflow on. The API defaults to main when unset"},
 					"repo":            schema.StringAttribute{Optional: true, Description: "GitHub repository name"},
 					"workflow_id":     schema.Int64Attribute{Optional: true, Description: "GitHub Actions workflow ID"},
 				},
 			},
+			"azure_devops": schema.SingleNestedBlock{
+				Description: "Azure DevOps Pipelines job agent configuration",
+				Attributes: map[string]schema.Attribute{
+					"organization_url":      schema.StringAttribute{Optional: true, Description: "Azure DevOps organization URL"},
+					"project":               schema.StringAttribute{Optional: true, Description: "Azure DevOps project name"},
+					"pipeline_id":           schema.Int64Attribute{Optional: true, Description: "Azure Pipelines pipeline definition ID"},
+					"personal_access_token": schema.StringAttribute{Optional: true, Sensitive: true, Description: "Azure DevOps personal access token"},
+				},
+			},
 			"terraform_cloud": schema.SingleNestedBlock{
 				Description: "Terraform Cloud job agent configuration",
 				Attributes: map[string]schema.Attribute{
@@ -134,6 +168,24 @@ func (r *DeploymentResource) Schema(ctx context.Context, req resource.SchemaRequ
 					"trigger_run_on_change": schema.BoolAttribute{Optional: true, Description: "Whether to create a TFC run on dispatch"},
 				},
 			},
+			"kubernetes": schema.SingleNestedBlock{
+				Description: "Kubernetes job agent configuration",
+				Attributes: map[string]schema.Attribute{
+					"kubeconfig_secret_ref": schema.StringAttribute{Optional: true, Description: "Name of the Kubernetes secret holding the kubeconfig for the target cluster"},
+					"namespace":             schema.StringAttribute{Optional: true, Description: "Namespace to create jobs in"},
+					"template":              schema.StringAttribute{Optional: true, Description: "Kubernetes Job manifest template"},
+				},
+			},
+			"webhook": schema.SingleNestedBlock{
+				Description: "Webhook job agent configuration",
+				Attributes: map[string]schema.Attribute{
+					"url":              schema.StringAttribute{Optional: true, Description: "URL the job is sent to"},
+					"method":           schema.StringAttribute{Optional: true, Description: "HTTP method of the request"},
+					"headers":          schema.MapAttribute{Optional: true, ElementType: types.StringType, Description: "Headers added to the request"},
+					"signing_secret":   schema.StringAttribute{Optional: true, Sensitive: true, Description: "Secret used to sign the request body with HMAC-SHA256"},
+					"payload_template": schema.StringAttribute{Optional: true, Description: "Template for the request body"},
+				},
+			},
 			"test_runner": schema.SingleNestedBlock{
 				Description: "Test runner job agent configuration",
 				Attributes: map[string]schema.Attribute{
@@ -163,16 +215,33 @@ func (r *DeploymentResource) ValidateConfig(ctx context.Context, req resource.Va
 	if data.GitHub != nil {
 		count++
 	}
+	if data.AzureDevOps != nil {
+		count++
+	}
 	if data.TerraformCloud != nil {
 		count++
 	}
+	if data.Kubernetes != nil {
+		count++
+	}
+	if data.Webhook != nil {
+		count++
+	}
 	if data.TestRunner != nil {
 		count++
 	}
 	if count > 1 {
 		resp.Diagnostics.AddError(
 			"Invalid job agent configuration",
-			"Only one of argocd, argo_workflow, github, terraform_cloud, or test_runner can be set.",
+			"Only one of argocd, argo_workflow, github, azure_devops, terraform_cloud, kubernetes, webhook, or test_runner can be set.",
+		)
+	}
+
+	if !data.JobAgentID.IsNull() && !data.JobAgentSelector.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("job_agent_id"),
+			"Invalid job agent configuration",
+			"Only one of job_agent_id and job_agent_selector can be set.",
 		)
 	}
 }
@@ -184,21 +253,20 @@ func (r *DeploymentResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.create())
+	defer cancel()
+
 	var resourceSelector *string
 	if cel := normalizeCEL(data.ResourceSelector); cel != "" {
 		resourceSelector = &cel
 	}
 
-	var jobAgentSelector *string
-	if !data.JobAgentSelector.IsNull() && !data.JobAgentSelector.IsUnknown() {
-		s := data.JobAgentSelector.ValueString()
-		jobAgentSelector = &s
-	}
+	jobAgentSelector := deploymentJobAgentSelector(&data)
 
 	requestBody := api.RequestDeploymentCreationJSONRequestBody{
 		Name:             data.Name.ValueString(),
 		Slug:             slug.Make(data.Name.ValueString()),
-		Metadata:         stringMapPointer(data.Metadata),
+		Metadata:         metadataRequest(r.workspace, data.Metadata),
 		ResourceSelector: resourceSelector,
 		JobAgentSelector: jobAgentSelector,
 		JobAgentConfig:   deploymentJobAgentConfigFromModel(&data),
@@ -211,7 +279,7 @@ func (r *DeploymentResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	if deployResp.StatusCode() != http.StatusAccepted {
-		resp.Diagnostics.AddError("Failed to create deployment", formatResponseError(deployResp.StatusCode(), deployResp.Body))
+		addResponseError(ctx, &resp.Diagnostics, "Failed to create deployment", req.Plan, deployResp.HTTPResponse, deployResp.Body)
 		return
 	}
 
@@ -230,6 +298,10 @@ func (r *DeploymentResource) Create(ctx context.Context, req resource.CreateRequ
 		}
 		switch getResp.StatusCode() {
 		case http.StatusOK:
+			if getResp.JSON200 != nil {
+				_, data.ResourceSelectorCanonical = reconcileSelector(data.ResourceSelector, types.StringNull(), getResp.JSON200.Deployment.ResourceSelector)
+				refreshDeploymentJobAgentConfig(&data, getResp.JSON200.Deployment.JobAgentConfig)
+			}
 			return true, nil
 		case http.StatusNotFound:
 			return false, nil
@@ -241,7 +313,11 @@ func (r *DeploymentResource) Create(ctx context.Context, req resource.CreateRequ
 		resp.Diagnostics.AddError("Failed to create deployment", fmt.Sprintf("Resource not available after creation: %s", err.Error()))
 		return
 	}
+	if data.ResourceSelectorCanonical.IsUnknown() {
+		data.ResourceSelectorCanonical = types.StringNull()
+	}
 
+	data.AppURL, data.EntityURL = entityURLs(r.workspace, "deployments", data.ID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
 
@@ -252,6 +328,9 @@ func (r *DeploymentResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.read())
+	defer cancel()
+
 	deployResp, err := r.workspace.Client.GetDeploymentWithResponse(ctx, r.workspace.ID.String(), data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read deployment", fmt.Sprintf("Failed to read deployment with ID '%s': %s", data.ID.ValueString(), err.Error()))
@@ -277,54 +356,63 @@ func (r *DeploymentResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	if deployResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError("Failed to read deployment", formatResponseError(deployResp.StatusCode(), deployResp.Body))
+		resp.Diagnostics.AddError("Failed to read deployment", formatResponseError(deployResp.HTTPResponse, deployResp.Body))
 		return
 	}
 
 	dep := deployResp.JSON200.Deployment
 	data.ID = types.StringValue(dep.Id)
-	data.Name = types.StringValue(dep.Name)
-	data.Metadata = stringMapValue(dep.Metadata)
+	data.Name = NewTrimmedStringValue(dep.Name)
+	data.Metadata = metadataFromAPI(r.workspace, dep.Metadata, data.Metadata)
 
-	if dep.ResourceSelector != nil && *dep.ResourceSelector != "" {
-		data.ResourceSelector = types.StringValue(*dep.ResourceSelector)
-	} else {
-		data.ResourceSelector = types.StringNull()
-	}
+	data.ResourceSelector, data.ResourceSelectorCanonical = reconcileSelector(data.ResourceSelector, data.ResourceSelectorCanonical, dep.ResourceSelector)
 
-	if dep.JobAgentSelector != "" {
+	match := jobAgentIDSelector.FindStringSubmatch(dep.JobAgentSelector)
+	switch {
+	case !data.JobAgentID.IsNull() && match != nil:
+		data.JobAgentID = types.StringValue(match[1])
+		data.JobAgentSelector = types.StringNull()
+	case dep.JobAgentSelector != "":
+		data.JobAgentID = types.StringNull()
 		data.JobAgentSelector = types.StringValue(dep.JobAgentSelector)
-	} else {
+	default:
+		data.JobAgentID = types.StringNull()
 		data.JobAgentSelector = types.StringNull()
 	}
 
-	setDeploymentBlocksFromConfig(&data, dep.JobAgentConfig)
+	// After import there is no block in state to say how the config is typed,
+	// so the type of the job agent the selector points at is used when known.
+	if deploymentBlockType(&data) == "" && len(dep.JobAgentConfig) > 0 {
+		setDeploymentBlockType(&data, r.selectedJobAgentBlockType(ctx, dep.JobAgentSelector))
+	}
+	refreshDeploymentJobAgentConfig(&data, dep.JobAgentConfig)
 
+	data.AppURL, data.EntityURL = entityURLs(r.workspace, "deployments", data.ID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
 
 func (r *DeploymentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data DeploymentResourceModel
+	var data, state DeploymentResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.update())
+	defer cancel()
+
 	var resourceSelector *string
 	if cel := normalizeCEL(data.ResourceSelector); cel != "" {
 		resourceSelector = &cel
 	}
 
-	var jobAgentSelector *string
-	if !data.JobAgentSelector.IsNull() && !data.JobAgentSelector.IsUnknown() {
-		s := data.JobAgentSelector.ValueString()
-		jobAgentSelector = &s
-	}
+	jobAgentSelector := deploymentJobAgentSelector(&data)
 
 	requestBody := api.UpsertDeploymentRequest{
 		Name:             data.Name.ValueString(),
 		Slug:             slug.Make(data.Name.ValueString()),
-		Metadata:         stringMapPointer(data.Metadata),
+		Metadata:         metadataRequest(r.workspace, data.Metadata),
 		ResourceSelector: resourceSelector,
 		JobAgentSelector: jobAgentSelector,
 		JobAgentConfig:   deploymentJobAgentConfigFromModel(&data),
@@ -337,7 +425,7 @@ func (r *DeploymentResource) Update(ctx context.Context, req resource.UpdateRequ
 	}
 
 	if deployResp.StatusCode() != http.StatusAccepted {
-		resp.Diagnostics.AddError("Failed to update deployment", formatResponseError(deployResp.StatusCode(), deployResp.Body))
+		addResponseError(ctx, &resp.Diagnostics, "Failed to update deployment", req.Plan, deployResp.HTTPResponse, deployResp.Body)
 		return
 	}
 
@@ -347,6 +435,39 @@ func (r *DeploymentResource) Update(ctx context.Context, req resource.UpdateRequ
 	}
 
 	data.ID = types.StringValue(deployResp.JSON202.Id)
+
+	// Re-read the deployment once the upsert has been applied so that job
+	// agent config defaults filled in by the server are mapped back onto the
+	// configured block rather than showing as drift on the next plan.
+	sentJobAgentConfig := requestBody.JobAgentConfig
+	err = waitForResource(ctx, func() (bool, error) {
+		getResp, err := r.workspace.Client.GetDeploymentWithResponse(ctx, r.workspace.ID.String(), data.ID.ValueString())
+		if err != nil {
+			return false, err
+		}
+		if getResp.StatusCode() != http.StatusOK || getResp.JSON200 == nil {
+			return false, fmt.Errorf("unexpected status %d", getResp.StatusCode())
+		}
+		dep := getResp.JSON200.Deployment
+		if !jobAgentConfigApplied(sentJobAgentConfig, dep.JobAgentConfig) {
+			return false, nil
+		}
+		if data.ResourceSelectorCanonical.IsUnknown() {
+			// The server returns either the submitted selector or its
+			// canonical rewrite. A cosmetic edit can rewrite to the same
+			// canonical form as before, so the returned selector is recorded
+			// as is rather than waited on.
+			_, data.ResourceSelectorCanonical = reconcileSelector(data.ResourceSelector, types.StringNull(), dep.ResourceSelector)
+		}
+		refreshDeploymentJobAgentConfig(&data, dep.JobAgentConfig)
+		return true, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update deployment", fmt.Sprintf("Deployment not applied after update: %s", err.Error()))
+		return
+	}
+
+	data.AppURL, data.EntityURL = entityURLs(r.workspace, "deployments", data.ID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
 
@@ -357,6 +478,9 @@ func (r *DeploymentResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.delete())
+	defer cancel()
+
 	clientResp, err := r.workspace.Client.RequestDeploymentDeletionWithResponse(ctx, r.workspace.ID.String(), data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to delete deployment", fmt.Sprintf("Failed to delete deployment: %s", err.Error()))
@@ -378,21 +502,30 @@ func (r *DeploymentResource) Delete(ctx context.Context, req resource.DeleteRequ
 		}
 	}
 
-	resp.Diagnostics.AddError("Failed to delete deployment", formatResponseError(clientResp.StatusCode(), clientResp.Body))
+	resp.Diagnostics.AddError("Failed to delete deployment", formatResponseError(clientResp.HTTPResponse, clientResp.Body))
 }
 
 type DeploymentResourceModel struct {
-	ID               types.String `tfsdk:"id"`
-	Name             types.String `tfsdk:"name"`
-	Metadata         types.Map    `tfsdk:"metadata"`
-	ResourceSelector types.String `tfsdk:"resource_selector"`
-	JobAgentSelector types.String `tfsdk:"job_agent_selector"`
+	ID               types.String       `tfsdk:"id"`
+	Name             TrimmedStringValue `tfsdk:"name"`
+	Metadata         MetadataValue      `tfsdk:"metadata"`
+	ResourceSelector types.String       `tfsdk:"resource_selector"`
+	JobAgentSelector types.String       `tfsdk:"job_agent_selector"`
+	JobAgentID       types.String       `tfsdk:"job_agent_id"`
+
+	ResourceSelectorCanonical types.String `tfsdk:"resource_selector_canonical"`
 
 	ArgoCD         *DeploymentArgoCDModel       `tfsdk:"argocd"`
 	ArgoWorkflow   *DeploymentArgoWorkflowModel `tfsdk:"argo_workflow"`
 	GitHub         *DeploymentGitHubModel       `tfsdk:"github"`
+	AzureDevOps    *DeploymentAzureDevOpsModel  `tfsdk:"azure_devops"`
 	TerraformCloud *DeploymentTFCModel          `tfsdk:"terraform_cloud"`
+	Kubernetes     *DeploymentKubernetesModel   `tfsdk:"kubernetes"`
+	Webhook        *DeploymentWebhookModel      `tfsdk:"webhook"`
 	TestRunner     *DeploymentTestRunnerModel   `tfsdk:"test_runner"`
+	AppURL         types.String                 `tfsdk:"app_url"`
+	EntityURL      types.String                 `tfsdk:"entity_url"`
+	Timeouts       *resourceTimeouts            `tfsdk:"timeouts"`
 }
 
 type DeploymentArgoCDModel struct {
@@ -418,6 +551,13 @@ type DeploymentGitHubModel struct {
 	WorkflowId     types.Int64  `tfsdk:"workflow_id"`
 }
 
+type DeploymentAzureDevOpsModel struct {
+	OrganizationUrl     types.String `tfsdk:"organization_url"`
+	Project             types.String `tfsdk:"project"`
+	PipelineId          types.Int64  `tfsdk:"pipeline_id"`
+	PersonalAccessToken types.String `tfsdk:"personal_access_token"`
+}
+
 type DeploymentTFCModel struct {
 	Address            types.String `tfsdk:"address"`
 	Organization       types.String `tfsdk:"organization"`
@@ -426,6 +566,20 @@ type DeploymentTFCModel struct {
 	TriggerRunOnChange types.Bool   `tfsdk:"trigger_run_on_change"`
 }
 
+type DeploymentKubernetesModel struct {
+	KubeconfigSecretRef types.String `tfsdk:"kubeconfig_secret_ref"`
+	Namespace           types.String `tfsdk:"namespace"`
+	Template            types.String `tfsdk:"template"`
+}
+
+type DeploymentWebhookModel struct {
+	Url             types.String `tfsdk:"url"`
+	Method          types.String `tfsdk:"method"`
+	Headers         types.Map    `tfsdk:"headers"`
+	SigningSecret   types.String `tfsdk:"signing_secret"`
+	PayloadTemplate types.String `tfsdk:"payload_template"`
+}
+
 type DeploymentTestRunnerModel struct {
 	DelaySeconds types.Int64  `tfsdk:"delay_seconds"`
 	Message      types.String `tfsdk:"message"`
@@ -474,6 +628,18 @@ func deploymentJobAgentConfigFromModel(data *DeploymentResourceModel) *map[strin
 			return nil
 		}
 		return &cfg
+	case data.AzureDevOps != nil:
+		cfg := map[string]any{}
+		setStringIfSet(cfg, "organizationUrl", data.AzureDevOps.OrganizationUrl)
+		setStringIfSet(cfg, "project", data.AzureDevOps.Project)
+		if !data.AzureDevOps.PipelineId.IsNull() && !data.AzureDevOps.PipelineId.IsUnknown() {
+			cfg["pipelineId"] = data.AzureDevOps.PipelineId.ValueInt64()
+		}
+		setStringIfSet(cfg, "personalAccessToken", data.AzureDevOps.PersonalAccessToken)
+		if len(cfg) == 0 {
+			return nil
+		}
+		return &cfg
 	case data.TerraformCloud != nil:
 		cfg := map[string]any{}
 		setStringIfSet(cfg, "address", data.TerraformCloud.Address)
@@ -487,6 +653,28 @@ func deploymentJobAgentConfigFromModel(data *DeploymentResourceModel) *map[strin
 			return nil
 		}
 		return &cfg
+	case data.Kubernetes != nil:
+		cfg := map[string]any{}
+		setStringIfSet(cfg, "kubeconfigSecretRef", data.Kubernetes.KubeconfigSecretRef)
+		setStringIfSet(cfg, "namespace", data.Kubernetes.Namespace)
+		setStringIfSet(cfg, "template", data.Kubernetes.Template)
+		if len(cfg) == 0 {
+			return nil
+		}
+		return &cfg
+	case data.Webhook != nil:
+		cfg := map[string]any{}
+		setStringIfSet(cfg, "url", data.Webhook.Url)
+		setStringIfSet(cfg, "method", data.Webhook.Method)
+		if headers := stringInterfaceMapPointer(data.Webhook.Headers); headers != nil {
+			cfg["headers"] = *headers
+		}
+		setStringIfSet(cfg, "signingSecret", data.Webhook.SigningSecret)
+		setStringIfSet(cfg, "payloadTemplate", data.Webhook.PayloadTemplate)
+		if len(cfg) == 0 {
+			return nil
+		}
+		return &cfg
 	case data.TestRunner != nil:
 		cfg := map[string]any{}
 		if !data.TestRunner.DelaySeconds.IsNull() && !data.TestRunner.DelaySeconds.IsUnknown() {
@@ -519,11 +707,16 @@ func setDeploymentBlocksFromConfig(data *DeploymentResourceModel, config map[str
 	priorArgoCD := data.ArgoCD
 	priorArgoWorkflow := data.ArgoWorkflow
 	priorTFC := data.TerraformCloud
+	priorAzureDevOps := data.AzureDevOps
+	priorWebhook := data.Webhook
 
 	data.ArgoCD = nil
 	data.ArgoWorkflow = nil
 	data.GitHub = nil
+	data.AzureDevOps = nil
 	data.TerraformCloud = nil
+	data.Kubernetes = nil
+	data.Webhook = nil
 	data.TestRunner = nil
 
 	if len(config) == 0 {
@@ -586,6 +779,20 @@ func setDeploymentBlocksFromConfig(data *DeploymentResourceModel, config map[str
 			gh.WorkflowId = types.Int64Value(toInt64(v))
 		}
 		data.GitHub = &gh
+	case "azure_devops":
+		azureDevOps := DeploymentAzureDevOpsModel{
+			OrganizationUrl:     stringValueOrNull(config["organizationUrl"]),
+			Project:             stringValueOrNull(config["project"]),
+			PipelineId:          types.Int64Null(),
+			PersonalAccessToken: stringValueOrNull(config["personalAccessToken"]),
+		}
+		if v, ok := config["pipelineId"]; ok && v != nil {
+			azureDevOps.PipelineId = types.Int64Value(toInt64(v))
+		}
+		if azureDevOps.PersonalAccessToken.IsNull() && priorAzureDevOps != nil && !priorAzureDevOps.PersonalAccessToken.IsNull() {
+			azureDevOps.PersonalAccessToken = priorAzureDevOps.PersonalAccessToken
+		}
+		data.AzureDevOps = &azureDevOps
 	case "terraform_cloud":
 		data.TerraformCloud = &DeploymentTFCModel{
 			Address:            stringValueOrNull(config["address"]),
@@ -597,6 +804,27 @@ func setDeploymentBlocksFromConfig(data *DeploymentResourceModel, config map[str
 		if data.TerraformCloud.Token.IsNull() && priorTFC != nil && !priorTFC.Token.IsNull() {
 			data.TerraformCloud.Token = priorTFC.Token
 		}
+	case "kubernetes":
+		data.Kubernetes = &DeploymentKubernetesModel{
+			KubeconfigSecretRef: stringValueOrNull(config["kubeconfigSecretRef"]),
+			Namespace:           stringValueOrNull(config["namespace"]),
+			Template:            stringValueOrNull(config["template"]),
+		}
+	case "webhook":
+		webhook := DeploymentWebhookModel{
+			Url:             stringValueOrNull(config["url"]),
+			Method:          stringValueOrNull(config["method"]),
+			Headers:         types.MapNull(types.StringType),
+			SigningSecret:   stringValueOrNull(config["signingSecret"]),
+			PayloadTemplate: stringValueOrNull(config["payloadTemplate"]),
+		}
+		if headers, ok := config["headers"].(map[string]interface{}); ok {
+			webhook.Headers = interfaceMapStringValue(headers)
+		}
+		if webhook.SigningSecret.IsNull() && priorWebhook != nil && !priorWebhook.SigningSecret.IsNull() {
+			webhook.SigningSecret = priorWebhook.SigningSecret
+		}
+		data.Webhook = &webhook
 	case "test_runner":
 		tr := DeploymentTestRunnerModel{
 			DelaySeconds: types.Int64Null(),
@@ -616,6 +844,56 @@ func setDeploymentBlocksFromConfig(data *DeploymentResourceModel, config map[str
 	}
 }
 
+// deploymentJobAgentServerDefaults lists, per block, the job agent config
+// values the API fills in when the key is left unset.
+var deploymentJobAgentServerDefaults = map[string]map[string]any{
+	"github": {"ref": "main"},
+}
+
+// refreshDeploymentJobAgentConfig populates the typed block from the job agent
+// config stored by the API. Server defaults for keys the block leaves unset are
+// dropped first, so the block keeps them null instead of reporting drift.
+func refreshDeploymentJobAgentConfig(data *DeploymentResourceModel, config map[string]interface{}) {
+	blockType := deploymentBlockType(data)
+	if blockType == "" {
+		blockType = inferBlockTypeFromConfig(config)
+	}
+
+	if defaults := deploymentJobAgentServerDefaults[blockType]; len(defaults) > 0 && len(config) > 0 {
+		var configured map[string]any
+		if sent := deploymentJobAgentConfigFromModel(data); sent != nil {
+			configured = *sent
+		}
+
+		normalized := make(map[string]interface{}, len(config))
+		for key, value := range config {
+			if _, set := configured[key]; !set {
+				if def, ok := defaults[key]; ok && jsonEquivalent(def, value) {
+					continue
+				}
+			}
+			normalized[key] = value
+		}
+		config = normalized
+	}
+
+	setDeploymentBlocksFromConfig(data, config)
+}
+
+// jobAgentConfigApplied reports whether the stored config reflects every key
+// that was sent. Keys the API does not return, such as secrets, are ignored.
+func jobAgentConfigApplied(sent *map[string]interface{}, stored map[string]interface{}) bool {
+	if sent == nil {
+		return true
+	}
+	for key, value := range *sent {
+		if got, ok := stored[key]; ok && !jsonEquivalent(value, got) {
+			return false
+		}
+	}
+	return true
+}
+
 type argoCDConfig struct {
 	ApiKey    string `json:"apiKey"`
 	ServerUrl string `json:"serverUrl"`
@@ -639,6 +917,12 @@ type githubConfig struct {
 	WorkflowId     *int64 `json:"workflowId"`
 }
 
+type azureDevOpsConfig struct {
+	OrganizationUrl string `json:"organizationUrl"`
+	Project         string `json:"project"`
+	PipelineId      *int64 `json:"pipelineId"`
+}
+
 type terraformCloudConfig struct {
 	Address            string `json:"address"`
 	Organization       string `json:"organization"`
@@ -647,6 +931,17 @@ type terraformCloudConfig struct {
 	TriggerRunOnChange *bool  `json:"triggerRunOnChange"`
 }
 
+type kubernetesConfig struct {
+	KubeconfigSecretRef string `json:"kubeconfigSecretRef"`
+	Namespace           string `json:"namespace"`
+	Template            string `json:"template"`
+}
+
+type webhookConfig struct {
+	Url             string `json:"url"`
+	PayloadTemplate string `json:"payloadTemplate"`
+}
+
 type testRunnerConfig struct {
 	DelaySeconds *int64 `json:"delaySeconds"`
 	Message      string `json:"message"`
@@ -665,6 +960,12 @@ func inferBlockTypeFromConfig(config map[string]interface{}) string {
 		return "github"
 	}
 
+	var ado azureDevOpsConfig
+	_ = json.Unmarshal(data, &ado)
+	if ado.OrganizationUrl != "" || ado.PipelineId != nil {
+		return "azure_devops"
+	}
+
 	var tfc terraformCloudConfig
 	_ = json.Unmarshal(data, &tfc)
 	if tfc.Organization != "" || tfc.Address != "" || tfc.TriggerRunOnChange != nil {
@@ -677,6 +978,18 @@ func inferBlockTypeFromConfig(config map[string]interface{}) string {
 		return "test_runner"
 	}
 
+	var webhook webhookConfig
+	_ = json.Unmarshal(data, &webhook)
+	if webhook.Url != "" || webhook.PayloadTemplate != "" {
+		return "webhook"
+	}
+
+	var k8s kubernetesConfig
+	_ = json.Unmarshal(data, &k8s)
+	if k8s.KubeconfigSecretRef != "" || k8s.Namespace != "" {
+		return "kubernetes"
+	}
+
 	var aw argoWorkflowConfig
 	_ = json.Unmarshal(data, &aw)
 	if aw.WebhookSecret != "" || aw.HttpInsecure != nil || aw.Name != "" {
@@ -700,8 +1013,14 @@ func deploymentBlockType(data *DeploymentResourceModel) string {
 		return "argo_workflow"
 	case data.GitHub != nil:
 		return "github"
+	case data.AzureDevOps != nil:
+		return "azure_devops"
 	case data.TerraformCloud != nil:
 		return "terraform_cloud"
+	case data.Kubernetes != nil:
+		return "kubernetes"
+	case data.Webhook != nil:
+		return "webhook"
 	case data.TestRunner != nil:
 		return "test_runner"
 	default:
@@ -709,6 +1028,61 @@ func deploymentBlockType(data *DeploymentResourceModel) string {
 	}
 }
 
+// deploymentJobAgentSelector returns the job agent selector to send: one
+// matching job_agent_id when it is set, or job_agent_selector.
+func deploymentJobAgentSelector(data *DeploymentResourceModel) *string {
+	if !data.JobAgentID.IsNull() && !data.JobAgentID.IsUnknown() {
+		selector := fmt.Sprintf("jobAgent.id == %q", data.JobAgentID.ValueString())
+		return &selector
+	}
+	if !data.JobAgentSelector.IsNull() && !data.JobAgentSelector.IsUnknown() {
+		selector := data.JobAgentSelector.ValueString()
+		return &selector
+	}
+	return nil
+}
+
+var jobAgentIDSelector = regexp.MustCompile(`^\s*jobAgent\.id\s*==\s*["']([^"']+)["']\s*$`)
+
+// selectedJobAgentBlockType returns the block type for the job agent matched
+// by selector, or "" when the selector does not pin a single agent by ID or
+// the agent cannot be read.
+func (r *DeploymentResource) selectedJobAgentBlockType(ctx context.Context, selector string) string {
+	match := jobAgentIDSelector.FindStringSubmatch(selector)
+	if match == nil {
+		return ""
+	}
+
+	agentResp, err := r.workspace.Client.GetJobAgentWithResponse(ctx, r.workspace.ID.String(), match[1])
+	if err != nil || agentResp.StatusCode() != http.StatusOK || agentResp.JSON200 == nil {
+		return ""
+	}
+	return jobAgentTypeBlocks[agentResp.JSON200.Type]
+}
+
+// setDeploymentBlockType adds an empty block of the given type so that
+// setDeploymentBlocksFromConfig populates it.
+func setDeploymentBlockType(data *DeploymentResourceModel, blockType string) {
+	switch blockType {
+	case "argocd":
+		data.ArgoCD = &DeploymentArgoCDModel{}
+	case "argo_workflow":
+		data.ArgoWorkflow = &DeploymentArgoWorkflowModel{}
+	case "github":
+		data.GitHub = &DeploymentGitHubModel{}
+	case "azure_devops":
+		data.AzureDevOps = &DeploymentAzureDevOpsModel{}
+	case "terraform_cloud":
+		data.TerraformCloud = &DeploymentTFCModel{}
+	case "kubernetes":
+		data.Kubernetes = &DeploymentKubernetesModel{}
+	case "webhook":
+		data.Webhook = &DeploymentWebhookModel{}
+	case "test_runner":
+		data.TestRunner = &DeploymentTestRunnerModel{}
+	}
+}
+
 func stringValueOrNull(value interface{}) types.String {
 	if value == nil {
 		return types.StringNull()
@@ -4,12 +4,16 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
 
@@ -34,6 +38,11 @@ func TestAccDeploymentResource(t *testing.T) {
 						tfjsonpath.New("name"),
 						knownvalue.StringExact(name),
 					),
+					statecheck.ExpectKnownValue(
+						"ctrlplane_deployment.test",
+						tfjsonpath.New("resource_selector_canonical"),
+						knownvalue.NotNull(),
+					),
 				},
 			},
 			{
@@ -87,3 +96,208 @@ resource "ctrlplane_deployment" "test" {
 }
 `, testAccProviderConfig(), name, name+"-ja", status, name, metadataValue, name)
 }
+
+func TestAccDeploymentResource_importBySlug(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-dep-import-%d", time.Now().UnixNano())
+	systemAndDeployment := func(s *terraform.State) (string, error) {
+		system, ok := s.RootModule().Resources["ctrlplane_system.test"]
+		if !ok {
+			return "", fmt.Errorf("system not found in state")
+		}
+		return system.Primary.Attributes["slug"] + "/" + name, nil
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentResourceImportConfig(name),
+			},
+			{
+				ResourceName:      "ctrlplane_deployment.test",
+				ImportState:       true,
+				ImportStateId:     name,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "ctrlplane_deployment.test",
+				ImportState:       true,
+				ImportStateIdFunc: systemAndDeployment,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName: "ctrlplane_deployment.test",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					key, err := systemAndDeployment(s)
+					return testAccWorkspaceImportID(key), err
+				},
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  "ctrlplane_deployment.test",
+				ImportState:   true,
+				ImportStateId: "tf-acc-no-such-system/" + name,
+				ExpectError:   regexp.MustCompile(`no system with slug 'tf-acc-no-such-system'`),
+			},
+		},
+	})
+}
+
+func testAccDeploymentResourceImportConfig(name string) string {
+	return fmt.Sprintf(`
+%s
+resource "ctrlplane_system" "test" {
+  name = %q
+}
+
+resource "ctrlplane_deployment" "test" {
+  name = %q
+}
+
+resource "ctrlplane_deployment_system_link" "test" {
+  deployment_id = ctrlplane_deployment.test.id
+  system_id     = ctrlplane_system.test.id
+}
+`, testAccProviderConfig(), name, name)
+}
+
+func TestAccDeploymentResource_timeouts(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-dep-timeouts-%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+resource "ctrlplane_deployment" "test" {
+  name = %q
+
+  timeouts {
+    create = "10m"
+    delete = "2m"
+  }
+}
+`, testAccProviderConfig(), name),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"ctrlplane_deployment.test",
+						tfjsonpath.New("timeouts").AtMapKey("create"),
+						knownvalue.StringExact("10m"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccDeploymentResource_githubDefaultRef(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-dep-gh-%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentResourceGitHubConfig(name, 1),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"ctrlplane_deployment.test",
+						tfjsonpath.New("github").AtMapKey("ref"),
+						knownvalue.Null(),
+					),
+				},
+			},
+			{
+				Config: testAccDeploymentResourceGitHubConfig(name, 2),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"ctrlplane_deployment.test",
+						tfjsonpath.New("github").AtMapKey("workflow_id"),
+						knownvalue.Int64Exact(2),
+					),
+					statecheck.ExpectKnownValue(
+						"ctrlplane_deployment.test",
+						tfjsonpath.New("github").AtMapKey("ref"),
+						knownvalue.Null(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccDeploymentResourceGitHubConfig(name string, workflowID int) string {
+	return fmt.Sprintf(`
+%s
+resource "ctrlplane_deployment" "test" {
+  name = %q
+
+  github {
+    installation_id = 12345
+    owner           = "ctrlplanedev"
+    repo            = "ctrlplane"
+    workflow_id     = %d
+  }
+}
+`, testAccProviderConfig(), name, workflowID)
+}
+
+func TestAccDeploymentResource_jobAgentID(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-deploy-agent-id-%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentResourceJobAgentIDConfig(name),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.CompareValuePairs(
+						"ctrlplane_deployment.test",
+						tfjsonpath.New("job_agent_id"),
+						"ctrlplane_job_agent.test",
+						tfjsonpath.New("id"),
+						compare.ValuesSame(),
+					),
+					statecheck.ExpectKnownValue(
+						"ctrlplane_deployment.test",
+						tfjsonpath.New("job_agent_selector"),
+						knownvalue.Null(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccDeploymentResourceJobAgentIDConfig(name string) string {
+	return fmt.Sprintf(`
+%s
+resource "ctrlplane_job_agent" "test" {
+  name = %q
+
+  test_runner {
+    delay_seconds = 5
+    status        = "successful"
+  }
+}
+
+resource "ctrlplane_deployment" "test" {
+  name         = %q
+  job_agent_id = ctrlplane_job_agent.test.id
+
+  test_runner {
+    delay_seconds = 10
+  }
+}
+`, testAccProviderConfig(), name+"-ja", name)
+}
@@ -19,6 +19,7 @@ import (
 var _ resource.Resource = &DeploymentSystemLinkResource{}
 var _ resource.ResourceWithImportState = &DeploymentSystemLinkResource{}
 var _ resource.ResourceWithConfigure = &DeploymentSystemLinkResource{}
+var _ resource.ResourceWithModifyPlan = &DeploymentSystemLinkResource{}
 
 func NewDeploymentSystemLinkResource() resource.Resource {
 	return &DeploymentSystemLinkResource{}
@@ -38,6 +39,10 @@ func (r *DeploymentSystemLinkResource) Metadata(ctx context.Context, req resourc
 	resp.TypeName = req.ProviderTypeName + "_deployment_system_link"
 }
 
+func (r *DeploymentSystemLinkResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	recordPlannedChange(ctx, r.workspace, "ctrlplane_deployment_system_link", req, resp)
+}
+
 func (r *DeploymentSystemLinkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	parts := strings.SplitN(req.ID, "/", 2)
 	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
@@ -116,7 +121,7 @@ func (r *DeploymentSystemLinkResource) Create(ctx context.Context, req resource.
 	}
 
 	if linkResp.StatusCode() != http.StatusAccepted {
-		resp.Diagnostics.AddError("Failed to link deployment to system", formatResponseError(linkResp.StatusCode(), linkResp.Body))
+		addResponseError(ctx, &resp.Diagnostics, "Failed to link deployment to system", req.Plan, linkResp.HTTPResponse, linkResp.Body)
 		return
 	}
 
@@ -150,7 +155,7 @@ func (r *DeploymentSystemLinkResource) Read(ctx context.Context, req resource.Re
 	case http.StatusNotFound:
 		resp.State.RemoveResource(ctx)
 	default:
-		resp.Diagnostics.AddError("Failed to read deployment system link", formatResponseError(linkResp.StatusCode(), linkResp.Body))
+		resp.Diagnostics.AddError("Failed to read deployment system link", formatResponseError(linkResp.HTTPResponse, linkResp.Body))
 	}
 }
 
@@ -186,6 +191,6 @@ func (r *DeploymentSystemLinkResource) Delete(ctx context.Context, req resource.
 	case http.StatusNotFound:
 		return
 	default:
-		resp.Diagnostics.AddError("Failed to unlink deployment from system", formatResponseError(unlinkResp.StatusCode(), unlinkResp.Body))
+		resp.Diagnostics.AddError("Failed to unlink deployment from system", formatResponseError(unlinkResp.HTTPResponse, unlinkResp.Body))
 	}
 }
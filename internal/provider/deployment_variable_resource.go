@@ -4,6 +4,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"math/big"
@@ -15,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -24,6 +26,7 @@ import (
 var _ resource.Resource = &DeploymentVariableResource{}
 var _ resource.ResourceWithImportState = &DeploymentVariableResource{}
 var _ resource.ResourceWithConfigure = &DeploymentVariableResource{}
+var _ resource.ResourceWithModifyPlan = &DeploymentVariableResource{}
 
 func NewDeploymentVariableResource() resource.Resource {
 	return &DeploymentVariableResource{}
@@ -37,6 +40,10 @@ func (r *DeploymentVariableResource) Metadata(ctx context.Context, req resource.
 	resp.TypeName = req.ProviderTypeName + "_deployment_variable"
 }
 
+func (r *DeploymentVariableResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	recordPlannedChange(ctx, r.workspace, "ctrlplane_deployment_variable", req, resp)
+}
+
 func (r *DeploymentVariableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -77,6 +84,12 @@ func (r *DeploymentVariableResource) Schema(ctx context.Context, req resource.Sc
 				Optional:    true,
 				Description: "The variable description",
 			},
+			"cascade_values": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "When true, every value of this variable is deleted before the variable itself, including values not managed by Terraform. Defaults to false.",
+			},
 		},
 	}
 }
@@ -109,7 +122,7 @@ func (r *DeploymentVariableResource) Create(ctx context.Context, req resource.Cr
 	}
 
 	if variableResp.StatusCode() != http.StatusAccepted {
-		resp.Diagnostics.AddError("Failed to create deployment variable", formatResponseError(variableResp.StatusCode(), variableResp.Body))
+		addResponseError(ctx, &resp.Diagnostics, "Failed to create deployment variable", req.Plan, variableResp.HTTPResponse, variableResp.Body)
 		return
 	}
 
@@ -182,7 +195,7 @@ func (r *DeploymentVariableResource) Read(ctx context.Context, req resource.Read
 	}
 
 	if variableResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError("Failed to read deployment variable", formatResponseError(variableResp.StatusCode(), variableResp.Body))
+		resp.Diagnostics.AddError("Failed to read deployment variable", formatResponseError(variableResp.HTTPResponse, variableResp.Body))
 		return
 	}
 
@@ -191,6 +204,11 @@ func (r *DeploymentVariableResource) Read(ctx context.Context, req resource.Read
 	data.DeploymentId = types.StringValue(variable.DeploymentId)
 	data.Key = types.StringValue(variable.Key)
 	data.Description = descriptionValue(variable.Description)
+	// cascade_values only affects Delete and is not stored by the API. It is
+	// null after import.
+	if data.CascadeValues.IsNull() {
+		data.CascadeValues = types.BoolValue(false)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -220,7 +238,7 @@ func (r *DeploymentVariableResource) Update(ctx context.Context, req resource.Up
 	}
 
 	if variableResp.StatusCode() != http.StatusAccepted {
-		resp.Diagnostics.AddError("Failed to update deployment variable", formatResponseError(variableResp.StatusCode(), variableResp.Body))
+		addResponseError(ctx, &resp.Diagnostics, "Failed to update deployment variable", req.Plan, variableResp.HTTPResponse, variableResp.Body)
 		return
 	}
 
@@ -240,6 +258,13 @@ func (r *DeploymentVariableResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
+	if data.CascadeValues.ValueBool() {
+		if err := r.deleteValues(ctx, data.ID.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Failed to delete deployment variable", fmt.Sprintf("Failed to delete variable values: %s", err.Error()))
+			return
+		}
+	}
+
 	variableResp, err := r.workspace.Client.RequestDeploymentVariableDeletionWithResponse(
 		ctx, r.workspace.ID.String(), data.ID.ValueString(),
 	)
@@ -263,14 +288,50 @@ func (r *DeploymentVariableResource) Delete(ctx context.Context, req resource.De
 		}
 	}
 
-	resp.Diagnostics.AddError("Failed to delete deployment variable", formatResponseError(variableResp.StatusCode(), variableResp.Body))
+	resp.Diagnostics.AddError("Failed to delete deployment variable", formatResponseError(variableResp.HTTPResponse, variableResp.Body))
+}
+
+// deleteValues deletes every value of the variable. Values that are already
+// gone are skipped; a failure to delete one value does not stop the others,
+// and every failure is returned.
+func (r *DeploymentVariableResource) deleteValues(ctx context.Context, variableID string) error {
+	variableResp, err := r.workspace.Client.GetDeploymentVariableWithResponse(ctx, r.workspace.ID.String(), variableID)
+	if err != nil {
+		return err
+	}
+	switch variableResp.StatusCode() {
+	case http.StatusOK:
+		if variableResp.JSON200 == nil {
+			return fmt.Errorf("empty response from server")
+		}
+	case http.StatusNotFound:
+		return nil
+	default:
+		return fmt.Errorf("%s", formatResponseError(variableResp.HTTPResponse, variableResp.Body))
+	}
+
+	var errs []error
+	for _, value := range variableResp.JSON200.Values {
+		valueResp, err := r.workspace.Client.RequestDeploymentVariableValueDeletionWithResponse(ctx, r.workspace.ID.String(), value.Id)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("value %s: %w", value.Id, err))
+			continue
+		}
+		switch valueResp.StatusCode() {
+		case http.StatusAccepted, http.StatusNoContent, http.StatusNotFound:
+		default:
+			errs = append(errs, fmt.Errorf("value %s: %s", value.Id, formatResponseError(valueResp.HTTPResponse, valueResp.Body)))
+		}
+	}
+	return errors.Join(errs...)
 }
 
 type DeploymentVariableResourceModel struct {
-	ID           types.String `tfsdk:"id"`
-	DeploymentId types.String `tfsdk:"deployment_id"`
-	Key          types.String `tfsdk:"key"`
-	Description  types.String `tfsdk:"description"`
+	ID            types.String `tfsdk:"id"`
+	DeploymentId  types.String `tfsdk:"deployment_id"`
+	Key           types.String `tfsdk:"key"`
+	Description   types.String `tfsdk:"description"`
+	CascadeValues types.Bool   `tfsdk:"cascade_values"`
 }
 
 func literalValueFromDynamic(value types.Dynamic) (*api.LiteralValue, error) {
@@ -339,7 +400,9 @@ func literalValueFromInterface(value interface{}) (*api.LiteralValue, error) {
 			return nil, err
 		}
 	case []interface{}:
-		return nil, fmt.Errorf("unsupported literal value type []interface{}")
+		// LiteralValue has no list variant, so lists are only accepted
+		// inside objects, where any JSON value may appear.
+		return nil, fmt.Errorf("a list cannot be a literal value on its own; wrap it in an object, e.g. { items = [...] }")
 	default:
 		return nil, fmt.Errorf("unsupported literal value type %T", value)
 	}
@@ -414,7 +477,21 @@ func attrValueFromInterface(value interface{}) (attr.Value, attr.Type, error) {
 		}
 		return obj, obj.Type(context.Background()), nil
 	case []interface{}:
-		return nil, nil, fmt.Errorf("unsupported value type []interface{}")
+		elemTypes := make([]attr.Type, 0, len(v))
+		elemValues := make([]attr.Value, 0, len(v))
+		for _, raw := range v {
+			convertedValue, convertedType, err := attrValueFromInterface(raw)
+			if err != nil {
+				return nil, nil, err
+			}
+			elemTypes = append(elemTypes, convertedType)
+			elemValues = append(elemValues, convertedValue)
+		}
+		tuple, diags := types.TupleValue(elemTypes, elemValues)
+		if diags.HasError() {
+			return nil, nil, fmt.Errorf("failed to build list value")
+		}
+		return tuple, tuple.Type(context.Background()), nil
 	default:
 		return nil, nil, fmt.Errorf("unsupported value type %T", value)
 	}
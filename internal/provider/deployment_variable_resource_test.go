@@ -4,9 +4,15 @@ package provider
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/ctrlplanedev/terraform-provider-ctrlplane/internal/api"
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
@@ -74,3 +80,99 @@ resource "ctrlplane_deployment_variable" "test" {
 }
 `, testAccProviderConfig(), key, key+"-deployment", key, key)
 }
+
+func TestAccDeploymentVariableResource_cascadeValues(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-var-cascade-%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+resource "ctrlplane_deployment" "test" {
+  name = %q
+}
+
+resource "ctrlplane_deployment_variable" "test" {
+  deployment_id  = ctrlplane_deployment.test.id
+  key            = %q
+  cascade_values = true
+}
+
+resource "ctrlplane_deployment_variable_value" "test" {
+  variable_id   = ctrlplane_deployment_variable.test.id
+  priority      = 1
+  literal_value = "cascade"
+}
+`, testAccProviderConfig(), name, name),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"ctrlplane_deployment_variable.test",
+						tfjsonpath.New("cascade_values"),
+						knownvalue.Bool(true),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestDeploymentVariableDeleteValuesCollectsErrors(t *testing.T) {
+	workspaceID := uuid.New()
+	statuses := map[string]int{"v1": http.StatusInternalServerError, "v2": http.StatusAccepted, "v3": http.StatusForbidden, "v4": http.StatusNotFound}
+
+	var mu sync.Mutex
+	deleted := map[string]bool{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		valuesPath := "/v1/workspaces/" + workspaceID.String() + "/deployment-variable-values/"
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/workspaces/"+workspaceID.String()+"/deployment-variables/var":
+			fmt.Fprint(w, `{"variable":{"id":"var","deploymentId":"dep","key":"k"},"values":[
+				{"id":"v1","deploymentVariableId":"var","priority":0,"value":"a"},
+				{"id":"v2","deploymentVariableId":"var","priority":0,"value":"b"},
+				{"id":"v3","deploymentVariableId":"var","priority":0,"value":"c"},
+				{"id":"v4","deploymentVariableId":"var","priority":0,"value":"d"}
+			]}`)
+		case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, valuesPath):
+			id := strings.TrimPrefix(r.URL.Path, valuesPath)
+			mu.Lock()
+			deleted[id] = true
+			mu.Unlock()
+			w.WriteHeader(statuses[id])
+			fmt.Fprintf(w, `{"id":%q,"message":"ok"}`, id)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := api.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &DeploymentVariableResource{workspace: &api.WorkspaceClient{ID: workspaceID, Client: client}}
+
+	err = r.deleteValues(t.Context(), "var")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	for _, id := range []string{"v1", "v3"} {
+		if !strings.Contains(err.Error(), "value "+id+":") {
+			t.Errorf("error %q does not mention failed value %s", err, id)
+		}
+	}
+	for _, id := range []string{"v2", "v4"} {
+		if strings.Contains(err.Error(), "value "+id+":") {
+			t.Errorf("error %q mentions value %s, which was deleted", err, id)
+		}
+	}
+	for id := range statuses {
+		if !deleted[id] {
+			t.Errorf("value %s was not deleted after an earlier failure", id)
+		}
+	}
+}
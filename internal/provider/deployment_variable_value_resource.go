@@ -4,8 +4,13 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/ctrlplanedev/terraform-provider-ctrlplane/internal/api"
 	"github.com/google/uuid"
@@ -22,6 +27,7 @@ import (
 var _ resource.Resource = &DeploymentVariableValueResource{}
 var _ resource.ResourceWithImportState = &DeploymentVariableValueResource{}
 var _ resource.ResourceWithConfigure = &DeploymentVariableValueResource{}
+var _ resource.ResourceWithModifyPlan = &DeploymentVariableValueResource{}
 var _ resource.ResourceWithValidateConfig = &DeploymentVariableValueResource{}
 
 func NewDeploymentVariableValueResource() resource.Resource {
@@ -35,12 +41,20 @@ type DeploymentVariableValueResource struct {
 type DeploymentVariableValueResourceModel struct {
 	ID               types.String  `tfsdk:"id"`
 	VariableId       types.String  `tfsdk:"variable_id"`
+	DeploymentId     types.String  `tfsdk:"deployment_id"`
 	Priority         types.Int64   `tfsdk:"priority"`
 	ResourceSelector types.String  `tfsdk:"resource_selector"`
 	LiteralValue     types.Dynamic `tfsdk:"literal_value"`
+	ValueType        types.String  `tfsdk:"value_type"`
 	ReferenceValue   types.Object  `tfsdk:"reference_value"`
+
+	SensitiveValue        types.String `tfsdk:"sensitive_value"`
+	SensitiveValueVersion types.Int64  `tfsdk:"sensitive_value_version"`
 }
 
+// literalValueTypes are the accepted values for value_type.
+var literalValueTypes = []string{"string", "number", "bool", "json"}
+
 var referenceValueAttrTypes = map[string]attr.Type{
 	"reference": types.StringType,
 	"path":      types.ListType{ElemType: types.StringType},
@@ -50,6 +64,10 @@ func (r *DeploymentVariableValueResource) Metadata(ctx context.Context, req reso
 	resp.TypeName = req.ProviderTypeName + "_deployment_variable_value"
 }
 
+func (r *DeploymentVariableValueResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	recordPlannedChange(ctx, r.workspace, "ctrlplane_deployment_variable_value", req, resp)
+}
+
 func (r *DeploymentVariableValueResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -86,6 +104,15 @@ func (r *DeploymentVariableValueResource) Schema(ctx context.Context, req resour
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"deployment_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the deployment the variable belongs to. Read from the variable when unset. When set, applies and refreshes fail if the variable belongs to a different deployment.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
 			"priority": schema.Int64Attribute{
 				Required:            true,
 				MarkdownDescription: "The priority of the variable value. Higher priority values take precedence when multiple values match.",
@@ -93,17 +120,22 @@ func (r *DeploymentVariableValueResource) Schema(ctx context.Context, req resour
 			"resource_selector": schema.StringAttribute{
 				Optional:            true,
 				MarkdownDescription: "A CEL expression to select which resources this value applies to.",
+				Validators:          celValidators(),
 				PlanModifiers: []planmodifier.String{
 					celNormalized(),
 				},
 			},
 			"literal_value": schema.DynamicAttribute{
 				Optional:            true,
-				MarkdownDescription: "A literal value (string, number, boolean, or object). Conflicts with `reference_value`.",
+				MarkdownDescription: "A literal value (string, number, boolean, or object). Objects may contain lists, including lists of objects, but a list cannot be the value on its own. Conflicts with `reference_value` and `sensitive_value`.",
+			},
+			"value_type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Forces how `literal_value` is encoded when sent to the API. One of `string`, `number`, `bool`, or `json`. With `json`, `literal_value` may be a JSON-encoded string such as `jsonencode({...})`. When unset, the encoding is inferred from the Terraform type of `literal_value`.",
 			},
 			"reference_value": schema.SingleNestedAttribute{
 				Optional:            true,
-				MarkdownDescription: "A reference value pointing to a property on the matched resource. Conflicts with `literal_value`.",
+				MarkdownDescription: "A reference value pointing to a property on the matched resource. Conflicts with `literal_value` and `sensitive_value`.",
 				Attributes: map[string]schema.Attribute{
 					"reference": schema.StringAttribute{
 						Required:            true,
@@ -116,6 +148,16 @@ func (r *DeploymentVariableValueResource) Schema(ctx context.Context, req resour
 					},
 				},
 			},
+			"sensitive_value": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				MarkdownDescription: "A secret string value. It is sent to the API on every apply but never stored in the Terraform plan or state, so changes to it cannot be detected: bump `sensitive_value_version` to update it. Requires Terraform 1.11 or later. Conflicts with `literal_value` and `reference_value`.",
+			},
+			"sensitive_value_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Version of `sensitive_value`, required with it. Change it to send a new `sensitive_value`. While set, the stored value is not read back into `literal_value`.",
+			},
 		},
 	}
 }
@@ -129,24 +171,75 @@ func (r *DeploymentVariableValueResource) ValidateConfig(ctx context.Context, re
 
 	hasLiteral := !data.LiteralValue.IsNull() && !data.LiteralValue.IsUnknown()
 	hasReference := !data.ReferenceValue.IsNull() && !data.ReferenceValue.IsUnknown()
+	hasSensitive := !data.SensitiveValue.IsNull() && !data.SensitiveValue.IsUnknown()
 
-	if hasLiteral && hasReference {
+	set := 0
+	for _, has := range []bool{hasLiteral, hasReference, hasSensitive} {
+		if has {
+			set++
+		}
+	}
+	if set > 1 {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("literal_value"),
 			"Conflicting value types",
-			"Only one of literal_value or reference_value may be specified, not both.",
+			"Only one of literal_value, reference_value or sensitive_value may be specified.",
 		)
 	}
 
-	if !hasLiteral && !hasReference {
-		// Allow unknowns during plan - only error if both are definitively null
-		if !data.LiteralValue.IsUnknown() && !data.ReferenceValue.IsUnknown() {
+	if set == 0 {
+		// Allow unknowns during plan - only error if all are definitively null
+		if !data.LiteralValue.IsUnknown() && !data.ReferenceValue.IsUnknown() && !data.SensitiveValue.IsUnknown() {
 			resp.Diagnostics.AddError(
 				"Missing value",
-				"Exactly one of literal_value or reference_value must be specified.",
+				"Exactly one of literal_value, reference_value or sensitive_value must be specified.",
 			)
 		}
 	}
+
+	if hasSensitive && data.SensitiveValueVersion.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("sensitive_value_version"),
+			"Missing sensitive value version",
+			"sensitive_value_version is required with sensitive_value, since changes to a write-only value cannot be detected.",
+		)
+	}
+	if !hasSensitive && !data.SensitiveValue.IsUnknown() && !data.SensitiveValueVersion.IsNull() && !data.SensitiveValueVersion.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("sensitive_value_version"),
+			"Invalid sensitive value version",
+			"sensitive_value_version can only be used with sensitive_value.",
+		)
+	}
+
+	if data.ValueType.IsNull() || data.ValueType.IsUnknown() {
+		return
+	}
+
+	valueType := data.ValueType.ValueString()
+	if !slices.Contains(literalValueTypes, valueType) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("value_type"),
+			"Invalid value type",
+			fmt.Sprintf("value_type must be one of %s, got %q.", strings.Join(literalValueTypes, ", "), valueType),
+		)
+		return
+	}
+
+	if hasReference || hasSensitive {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("value_type"),
+			"Invalid value type",
+			"value_type can only be used with literal_value.",
+		)
+		return
+	}
+
+	if hasLiteral {
+		if _, err := literalValueFromDynamicAs(data.LiteralValue, valueType); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("literal_value"), "Invalid literal value", err.Error())
+		}
+	}
 }
 
 func (r *DeploymentVariableValueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -156,6 +249,17 @@ func (r *DeploymentVariableValueResource) Create(ctx context.Context, req resour
 		return
 	}
 
+	// Write-only attributes are only present in configuration.
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("sensitive_value"), &data.SensitiveValue)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setDeploymentID(ctx, &data, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	valueID := data.ID.ValueString()
 	if data.ID.IsNull() || data.ID.IsUnknown() || valueID == "" {
 		valueID = uuid.NewString()
@@ -163,6 +267,7 @@ func (r *DeploymentVariableValueResource) Create(ctx context.Context, req resour
 	}
 
 	apiValue, err := valueFromVariableValueModel(data)
+	data.SensitiveValue = types.StringNull()
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create deployment variable value", fmt.Sprintf("Failed to build value: %s", err.Error()))
 		return
@@ -189,7 +294,7 @@ func (r *DeploymentVariableValueResource) Create(ctx context.Context, req resour
 	}
 
 	if valueResp.StatusCode() != http.StatusAccepted {
-		resp.Diagnostics.AddError("Failed to create deployment variable value", formatResponseError(valueResp.StatusCode(), valueResp.Body))
+		addResponseError(ctx, &resp.Diagnostics, "Failed to create deployment variable value", req.Plan, valueResp.HTTPResponse, valueResp.Body)
 		return
 	}
 
@@ -262,13 +367,18 @@ func (r *DeploymentVariableValueResource) Read(ctx context.Context, req resource
 	}
 
 	if valueResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError("Failed to read deployment variable value", formatResponseError(valueResp.StatusCode(), valueResp.Body))
+		resp.Diagnostics.AddError("Failed to read deployment variable value", formatResponseError(valueResp.HTTPResponse, valueResp.Body))
 		return
 	}
 
 	value := valueResp.JSON200
 	data.ID = types.StringValue(value.Id)
 	data.VariableId = types.StringValue(value.DeploymentVariableId)
+
+	resp.Diagnostics.Append(r.setDeploymentID(ctx, &data, r.workspace.Features.OrphanedValueWarnings)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	data.Priority = types.Int64Value(value.Priority)
 
 	if value.ResourceSelector != nil && *value.ResourceSelector != "" {
@@ -277,15 +387,80 @@ func (r *DeploymentVariableValueResource) Read(ctx context.Context, req resource
 		data.ResourceSelector = types.StringNull()
 	}
 
+	// A sensitive value is never read back, so it cannot land in state.
+	if !data.SensitiveValueVersion.IsNull() {
+		data.LiteralValue = types.DynamicNull()
+		data.ReferenceValue = types.ObjectNull(referenceValueAttrTypes)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	priorLiteral := data.LiteralValue
 	diags := setValueOnModel(ctx, &data, value.Value)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if literalUnchanged(priorLiteral, data.ValueType.ValueString(), value.Value) {
+		data.LiteralValue = priorLiteral
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// setDeploymentID sets deployment_id to the deployment that owns the value's
+// variable, failing when deployment_id is already set to a different one. A
+// missing variable leaves deployment_id as it is and, when warnOrphaned is
+// set, adds a warning, since the variable is deleted without cascade_values.
+func (r *DeploymentVariableValueResource) setDeploymentID(ctx context.Context, data *DeploymentVariableValueResourceModel, warnOrphaned bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	variableID := data.VariableId.ValueString()
+
+	variableResp, err := r.workspace.Client.GetDeploymentVariableWithResponse(ctx, r.workspace.ID.String(), variableID)
+	if err != nil {
+		diags.AddError("Failed to read deployment variable", fmt.Sprintf("Failed to read deployment variable with ID '%s': %s", variableID, err.Error()))
+		return diags
+	}
+
+	switch {
+	case variableResp.StatusCode() == http.StatusNotFound:
+		if data.DeploymentId.IsUnknown() {
+			data.DeploymentId = types.StringNull()
+		}
+		if warnOrphaned {
+			diags.AddWarning(
+				"Orphaned deployment variable value",
+				fmt.Sprintf(
+					"Deployment variable %s no longer exists, so this value is not applied to any release. "+
+						"Remove this value from the configuration, or recreate the variable. Set cascade_values on "+
+						"ctrlplane_deployment_variable to delete values along with their variable.",
+					variableID,
+				),
+			)
+		}
+		return diags
+	case variableResp.StatusCode() != http.StatusOK || variableResp.JSON200 == nil:
+		diags.AddError("Failed to read deployment variable", formatResponseError(variableResp.HTTPResponse, variableResp.Body))
+		return diags
+	}
+
+	deploymentID := variableResp.JSON200.Variable.DeploymentId
+	if !data.DeploymentId.IsNull() && !data.DeploymentId.IsUnknown() && data.DeploymentId.ValueString() != deploymentID {
+		diags.AddAttributeError(
+			path.Root("deployment_id"),
+			"Deployment variable value belongs to another deployment",
+			fmt.Sprintf(
+				"Variable %s belongs to deployment %s, not %s. The variable was recreated under another deployment, "+
+					"or variable_id and deployment_id refer to different deployments. Correct variable_id or deployment_id.",
+				variableID, deploymentID, data.DeploymentId.ValueString(),
+			),
+		)
+		return diags
+	}
+	data.DeploymentId = types.StringValue(deploymentID)
+	return diags
+}
+
 func (r *DeploymentVariableValueResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data DeploymentVariableValueResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -293,7 +468,18 @@ func (r *DeploymentVariableValueResource) Update(ctx context.Context, req resour
 		return
 	}
 
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("sensitive_value"), &data.SensitiveValue)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setDeploymentID(ctx, &data, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	apiValue, err := valueFromVariableValueModel(data)
+	data.SensitiveValue = types.StringNull()
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to update deployment variable value", fmt.Sprintf("Failed to build value: %s", err.Error()))
 		return
@@ -323,7 +509,7 @@ func (r *DeploymentVariableValueResource) Update(ctx context.Context, req resour
 	}
 
 	if valueResp.StatusCode() != http.StatusAccepted {
-		resp.Diagnostics.AddError("Failed to update deployment variable value", formatResponseError(valueResp.StatusCode(), valueResp.Body))
+		addResponseError(ctx, &resp.Diagnostics, "Failed to update deployment variable value", req.Plan, valueResp.HTTPResponse, valueResp.Body)
 		return
 	}
 
@@ -366,7 +552,7 @@ func (r *DeploymentVariableValueResource) Delete(ctx context.Context, req resour
 		}
 	}
 
-	resp.Diagnostics.AddError("Failed to delete deployment variable value", formatResponseError(valueResp.StatusCode(), valueResp.Body))
+	resp.Diagnostics.AddError("Failed to delete deployment variable value", formatResponseError(valueResp.HTTPResponse, valueResp.Body))
 }
 
 // valueFromVariableValueModel converts the Terraform model into the API Value union type.
@@ -411,7 +597,7 @@ func valueFromVariableValueModel(data DeploymentVariableValueResourceModel) (*ap
 	}
 
 	if !data.LiteralValue.IsNull() && !data.LiteralValue.IsUnknown() {
-		literal, err := literalValueFromDynamic(data.LiteralValue)
+		literal, err := literalValueFromDynamicAs(data.LiteralValue, data.ValueType.ValueString())
 		if err != nil {
 			return nil, fmt.Errorf("failed to convert literal value: %w", err)
 		}
@@ -426,7 +612,19 @@ func valueFromVariableValueModel(data DeploymentVariableValueResourceModel) (*ap
 		return &value, nil
 	}
 
-	return nil, fmt.Errorf("one of literal_value or reference_value must be provided")
+	if !data.SensitiveValue.IsNull() && !data.SensitiveValue.IsUnknown() {
+		literal, err := literalValueFromInterface(data.SensitiveValue.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert sensitive value: %w", err)
+		}
+		if err := value.FromLiteralValue(*literal); err != nil {
+			return nil, fmt.Errorf("failed to set sensitive value: %w", err)
+		}
+
+		return &value, nil
+	}
+
+	return nil, fmt.Errorf("one of literal_value, reference_value or sensitive_value must be provided")
 }
 
 // setValueOnModel reads from the API Value union and sets the appropriate field on the model.
@@ -472,3 +670,114 @@ func setValueOnModel(_ context.Context, data *DeploymentVariableValueResourceMod
 	data.ReferenceValue = types.ObjectNull(referenceValueAttrTypes)
 	return diags
 }
+
+// literalValueFromDynamicAs converts value to an API literal, coercing it to
+// valueType when set instead of inferring the encoding from the Terraform type.
+func literalValueFromDynamicAs(value types.Dynamic, valueType string) (*api.LiteralValue, error) {
+	if valueType == "" {
+		return literalValueFromDynamic(value)
+	}
+	if value.IsNull() || value.IsUnknown() {
+		return nil, nil
+	}
+
+	tfValue, err := value.ToTerraformValue(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	decoded, err := terraformValueToInterface(tfValue)
+	if err != nil {
+		return nil, err
+	}
+
+	switch valueType {
+	case "string":
+		switch v := decoded.(type) {
+		case string:
+			return literalValueFromInterface(v)
+		case bool:
+			return literalValueFromInterface(strconv.FormatBool(v))
+		case int64:
+			return literalValueFromInterface(strconv.FormatInt(v, 10))
+		case float64:
+			return literalValueFromInterface(strconv.FormatFloat(v, 'f', -1, 64))
+		}
+	case "number":
+		switch v := decoded.(type) {
+		case int64, float64:
+			return literalValueFromInterface(v)
+		case string:
+			number, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return nil, fmt.Errorf("literal_value %q is not a number", v)
+			}
+			return literalValueFromInterface(number)
+		}
+	case "bool":
+		switch v := decoded.(type) {
+		case bool:
+			return literalValueFromInterface(v)
+		case string:
+			boolean, err := strconv.ParseBool(v)
+			if err != nil {
+				return nil, fmt.Errorf("literal_value %q is not a boolean", v)
+			}
+			return literalValueFromInterface(boolean)
+		}
+	case "json":
+		switch v := decoded.(type) {
+		case map[string]interface{}:
+			return literalValueFromInterface(v)
+		case string:
+			var parsed interface{}
+			if err := json.Unmarshal([]byte(v), &parsed); err != nil {
+				return nil, fmt.Errorf("literal_value is not valid JSON: %w", err)
+			}
+			return literalValueFromInterface(parsed)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported value_type %q", valueType)
+	}
+
+	return nil, fmt.Errorf("literal_value of type %T cannot be encoded as %s", decoded, valueType)
+}
+
+// literalUnchanged reports whether prior, encoded with valueType, matches
+// the literal stored by the API once both are normalized to JSON. Values read
+// back in their API form can differ from configuration without a real change:
+// coerced values come back as 5 rather than "5", maps as objects, lists as
+// tuples, and numbers with a different type or precision. The configured form
+// is kept when they agree, so only real changes show up in plans.
+func literalUnchanged(prior types.Dynamic, valueType string, value api.Value) bool {
+	if prior.IsNull() || prior.IsUnknown() {
+		return false
+	}
+
+	stored, err := value.AsLiteralValue()
+	if err != nil {
+		return false
+	}
+	expected, err := literalValueFromDynamicAs(prior, valueType)
+	if err != nil || expected == nil {
+		return false
+	}
+
+	return jsonEquivalent(expected, stored)
+}
+
+func jsonEquivalent(a, b interface{}) bool {
+	aJSON, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+	bJSON, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+
+	var aDecoded, bDecoded interface{}
+	if json.Unmarshal(aJSON, &aDecoded) != nil || json.Unmarshal(bJSON, &bDecoded) != nil {
+		return false
+	}
+	return reflect.DeepEqual(aDecoded, bDecoded)
+}
@@ -0,0 +1,326 @@
+// Copyright IBM Corp. 2021, 2026
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccDeploymentVariableValueResource_sensitive(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-var-sensitive-%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentVariableValueSensitiveConfig(name, "first-secret", 1),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"ctrlplane_deployment_variable_value.test",
+						tfjsonpath.New("sensitive_value"),
+						knownvalue.Null(),
+					),
+					statecheck.ExpectKnownValue(
+						"ctrlplane_deployment_variable_value.test",
+						tfjsonpath.New("literal_value"),
+						knownvalue.Null(),
+					),
+				},
+			},
+			{
+				Config: testAccDeploymentVariableValueSensitiveConfig(name, "second-secret", 2),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"ctrlplane_deployment_variable_value.test",
+						tfjsonpath.New("sensitive_value_version"),
+						knownvalue.Int64Exact(2),
+					),
+					statecheck.ExpectKnownValue(
+						"ctrlplane_deployment_variable_value.test",
+						tfjsonpath.New("literal_value"),
+						knownvalue.Null(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccDeploymentVariableValueSensitiveConfig(name, secret string, version int) string {
+	return fmt.Sprintf(`
+%s
+resource "ctrlplane_deployment" "test" {
+  name              = %q
+  resource_selector = "resource.name == '%s'"
+}
+
+resource "ctrlplane_deployment_variable" "test" {
+  deployment_id = ctrlplane_deployment.test.id
+  key           = "api_token"
+}
+
+resource "ctrlplane_deployment_variable_value" "test" {
+  variable_id             = ctrlplane_deployment_variable.test.id
+  priority                = 0
+  sensitive_value         = %q
+  sensitive_value_version = %d
+}
+`, testAccProviderConfig(), name, name, secret, version)
+}
+
+func TestAccDeploymentVariableValueResource_deploymentID(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-var-deployment-%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentVariableValueDeploymentIDConfig(name, ""),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.CompareValuePairs(
+						"ctrlplane_deployment_variable_value.test",
+						tfjsonpath.New("deployment_id"),
+						"ctrlplane_deployment.test",
+						tfjsonpath.New("id"),
+						compare.ValuesSame(),
+					),
+				},
+			},
+			{
+				Config:      testAccDeploymentVariableValueDeploymentIDConfig(name, "ctrlplane_deployment.other.id"),
+				ExpectError: regexp.MustCompile(`belongs to another deployment`),
+			},
+		},
+	})
+}
+
+func testAccDeploymentVariableValueDeploymentIDConfig(name, deploymentID string) string {
+	deploymentIDLine := ""
+	if deploymentID != "" {
+		deploymentIDLine = "deployment_id = " + deploymentID
+	}
+	return fmt.Sprintf(`
+%s
+resource "ctrlplane_deployment" "test" {
+  name              = %q
+  resource_selector = "resource.name == '%s'"
+}
+
+resource "ctrlplane_deployment" "other" {
+  name              = "%s-other"
+  resource_selector = "resource.name == '%s'"
+}
+
+resource "ctrlplane_deployment_variable" "test" {
+  deployment_id = ctrlplane_deployment.test.id
+  key           = "region"
+}
+
+resource "ctrlplane_deployment_variable_value" "test" {
+  variable_id   = ctrlplane_deployment_variable.test.id
+  priority      = 0
+  literal_value = "us-east-1"
+  %s
+}
+`, testAccProviderConfig(), name, name, name, name, deploymentIDLine)
+}
+
+// TestAccDeploymentVariableValueResource_dynamicLiteral round-trips each kind of
+// literal through the dynamic literal_value attribute. How the CLI encodes
+// dynamic values has changed between releases, so this runs on every version
+// in the acceptance test matrix.
+func TestAccDeploymentVariableValueResource_dynamicLiteral(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-var-dynamic-%d", time.Now().UnixNano())
+
+	steps := []struct {
+		literal string
+		check   knownvalue.Check
+	}{
+		{`"us-east-1"`, knownvalue.StringExact("us-east-1")},
+		{`3`, knownvalue.Int64Exact(3)},
+		{`true`, knownvalue.Bool(true)},
+		{`{ replicas = 3, tier = "gold" }`, knownvalue.ObjectExact(map[string]knownvalue.Check{
+			"replicas": knownvalue.Int64Exact(3),
+			"tier":     knownvalue.StringExact("gold"),
+		})},
+		{`{ regions = ["us-east-1", "eu-west-1"], pools = [{ name = "a", size = 2 }] }`, knownvalue.ObjectExact(map[string]knownvalue.Check{
+			"regions": knownvalue.ListExact([]knownvalue.Check{
+				knownvalue.StringExact("us-east-1"),
+				knownvalue.StringExact("eu-west-1"),
+			}),
+			"pools": knownvalue.ListExact([]knownvalue.Check{
+				knownvalue.ObjectExact(map[string]knownvalue.Check{
+					"name": knownvalue.StringExact("a"),
+					"size": knownvalue.Int64Exact(2),
+				}),
+			}),
+		})},
+		{`{ ratio = 0.25, labels = tomap({ team = "infra" }), zones = tolist(["a", "b"]) }`, knownvalue.ObjectExact(map[string]knownvalue.Check{
+			"ratio": knownvalue.Float64Exact(0.25),
+			"labels": knownvalue.MapExact(map[string]knownvalue.Check{
+				"team": knownvalue.StringExact("infra"),
+			}),
+			"zones": knownvalue.ListExact([]knownvalue.Check{
+				knownvalue.StringExact("a"),
+				knownvalue.StringExact("b"),
+			}),
+		})},
+	}
+
+	testSteps := make([]resource.TestStep, 0, len(steps))
+	for _, step := range steps {
+		testSteps = append(testSteps, resource.TestStep{
+			Config: testAccDeploymentVariableValueLiteralConfig(name, step.literal),
+			ConfigStateChecks: []statecheck.StateCheck{
+				statecheck.ExpectKnownValue(
+					"ctrlplane_deployment_variable_value.test",
+					tfjsonpath.New("literal_value"),
+					step.check,
+				),
+			},
+			ConfigPlanChecks: resource.ConfigPlanChecks{
+				PostApplyPostRefresh: []plancheck.PlanCheck{
+					plancheck.ExpectEmptyPlan(),
+				},
+			},
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps:                    testSteps,
+	})
+}
+
+// TestAccDeploymentVariableValueResource_valueType applies literals whose
+// value_type coerces them to another JSON type. The API stores the coerced
+// value, so each step also checks that refreshing keeps the configured form
+// rather than planning a change back to it.
+func TestAccDeploymentVariableValueResource_valueType(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-var-value-type-%d", time.Now().UnixNano())
+
+	steps := []struct {
+		literal   string
+		valueType string
+		check     knownvalue.Check
+	}{
+		{`"3"`, "number", knownvalue.StringExact("3")},
+		{`"2.5"`, "number", knownvalue.StringExact("2.5")},
+		{`"true"`, "bool", knownvalue.StringExact("true")},
+		{`3`, "string", knownvalue.Int64Exact(3)},
+		{`false`, "string", knownvalue.Bool(false)},
+		{`jsonencode({ replicas = 3, tier = "gold" })`, "json", knownvalue.StringExact(`{"replicas":3,"tier":"gold"}`)},
+		{`{ replicas = 3 }`, "json", knownvalue.ObjectExact(map[string]knownvalue.Check{
+			"replicas": knownvalue.Int64Exact(3),
+		})},
+	}
+
+	testSteps := make([]resource.TestStep, 0, len(steps)+1)
+	for _, step := range steps {
+		testSteps = append(testSteps, resource.TestStep{
+			Config: testAccDeploymentVariableValueTypedLiteralConfig(name, step.literal, step.valueType),
+			ConfigStateChecks: []statecheck.StateCheck{
+				statecheck.ExpectKnownValue(
+					"ctrlplane_deployment_variable_value.test",
+					tfjsonpath.New("literal_value"),
+					step.check,
+				),
+				statecheck.ExpectKnownValue(
+					"ctrlplane_deployment_variable_value.test",
+					tfjsonpath.New("value_type"),
+					knownvalue.StringExact(step.valueType),
+				),
+			},
+			ConfigPlanChecks: resource.ConfigPlanChecks{
+				PostApplyPostRefresh: []plancheck.PlanCheck{
+					plancheck.ExpectEmptyPlan(),
+				},
+			},
+		})
+	}
+	testSteps = append(testSteps, resource.TestStep{
+		Config:      testAccDeploymentVariableValueTypedLiteralConfig(name, `"three"`, "number"),
+		ExpectError: regexp.MustCompile(`is not a number`),
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps:                    testSteps,
+	})
+}
+
+func testAccDeploymentVariableValueTypedLiteralConfig(name, literal, valueType string) string {
+	return fmt.Sprintf(`
+%s
+resource "ctrlplane_deployment" "test" {
+  name              = %q
+  resource_selector = "resource.name == '%s'"
+}
+
+resource "ctrlplane_deployment_variable" "test" {
+  deployment_id = ctrlplane_deployment.test.id
+  key           = "settings"
+}
+
+resource "ctrlplane_deployment_variable_value" "test" {
+  variable_id   = ctrlplane_deployment_variable.test.id
+  priority      = 0
+  literal_value = %s
+  value_type    = %q
+}
+`, testAccProviderConfig(), name, name, literal, valueType)
+}
+
+func TestAccDeploymentVariableValueResource_topLevelList(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-var-list-%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDeploymentVariableValueLiteralConfig(name, `["us-east-1", "eu-west-1"]`),
+				ExpectError: regexp.MustCompile(`list cannot be a literal value`),
+			},
+		},
+	})
+}
+
+func testAccDeploymentVariableValueLiteralConfig(name, literal string) string {
+	return fmt.Sprintf(`
+%s
+resource "ctrlplane_deployment" "test" {
+  name              = %q
+  resource_selector = "resource.name == '%s'"
+}
+
+resource "ctrlplane_deployment_variable" "test" {
+  deployment_id = ctrlplane_deployment.test.id
+  key           = "settings"
+}
+
+resource "ctrlplane_deployment_variable_value" "test" {
+  variable_id   = ctrlplane_deployment_variable.test.id
+  priority      = 0
+  literal_value = %s
+}
+`, testAccProviderConfig(), name, name, literal)
+}
@@ -0,0 +1,605 @@
+// Copyright IBM Corp. 2021, 2026
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/ctrlplanedev/terraform-provider-ctrlplane/internal/api"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &DeploymentVariableValuesResource{}
+var _ resource.ResourceWithImportState = &DeploymentVariableValuesResource{}
+var _ resource.ResourceWithConfigure = &DeploymentVariableValuesResource{}
+var _ resource.ResourceWithModifyPlan = &DeploymentVariableValuesResource{}
+var _ resource.ResourceWithValidateConfig = &DeploymentVariableValuesResource{}
+
+func NewDeploymentVariableValuesResource() resource.Resource {
+	return &DeploymentVariableValuesResource{}
+}
+
+// DeploymentVariableValuesResource manages many values of one deployment
+// variable. The API has no bulk endpoint, so values are still written one at a
+// time, but only values whose configuration changed are sent and the whole set
+// is refreshed with a single read of the variable.
+type DeploymentVariableValuesResource struct {
+	workspace *api.WorkspaceClient
+}
+
+type DeploymentVariableValuesResourceModel struct {
+	ID         types.String                                  `tfsdk:"id"`
+	VariableId types.String                                  `tfsdk:"variable_id"`
+	Values     map[string]DeploymentVariableValuesEntryModel `tfsdk:"values"`
+}
+
+type DeploymentVariableValuesEntryModel struct {
+	ID               types.String `tfsdk:"id"`
+	Priority         types.Int64  `tfsdk:"priority"`
+	ResourceSelector types.String `tfsdk:"resource_selector"`
+	LiteralValue     types.String `tfsdk:"literal_value"`
+	ValueType        types.String `tfsdk:"value_type"`
+	ReferenceValue   types.Object `tfsdk:"reference_value"`
+}
+
+func (r *DeploymentVariableValuesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment_variable_values"
+}
+
+func (r *DeploymentVariableValuesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	recordPlannedChange(ctx, r.workspace, "ctrlplane_deployment_variable_values", req, resp)
+}
+
+// ImportState accepts either a variable ID, which adopts every value of the
+// variable keyed by its value ID, or variable_id/name=value_id,... to import
+// the listed values under the names used in configuration.
+func (r *DeploymentVariableValuesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	variableID, list, named := strings.Cut(req.ID, "/")
+	if variableID == "" || (named && list == "") {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Import ID must be in the format: variable_id or variable_id/name=value_id,name=value_id",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), variableID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("variable_id"), variableID)...)
+	if !named {
+		return
+	}
+
+	values := map[string]DeploymentVariableValuesEntryModel{}
+	for _, pair := range strings.Split(list, ",") {
+		name, valueID, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok || name == "" || valueID == "" {
+			resp.Diagnostics.AddError(
+				"Invalid import ID",
+				fmt.Sprintf("Expected name=value_id, got %q. Import ID must be in the format: variable_id/name=value_id,name=value_id", pair),
+			)
+			return
+		}
+		if _, ok := values[name]; ok {
+			resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Value name %q is listed more than once.", name))
+			return
+		}
+		values[name] = DeploymentVariableValuesEntryModel{
+			ID:               types.StringValue(valueID),
+			Priority:         types.Int64Null(),
+			ResourceSelector: types.StringNull(),
+			LiteralValue:     types.StringNull(),
+			ValueType:        types.StringNull(),
+			ReferenceValue:   types.ObjectNull(referenceValueAttrTypes),
+		}
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("values"), values)...)
+}
+
+func (r *DeploymentVariableValuesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	workspace, ok := req.ProviderData.(*api.WorkspaceClient)
+	if !ok {
+		resp.Diagnostics.AddError("Invalid provider data", "The provider data is not a *api.WorkspaceClient")
+		return
+	}
+
+	r.workspace = workspace
+}
+
+func (r *DeploymentVariableValuesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages many value overrides of a single deployment variable as one resource. " +
+			"Each entry of `values` is equivalent to a `ctrlplane_deployment_variable_value`; entries are keyed by an arbitrary name so that " +
+			"adding or removing one does not affect the others. Values of the variable that are not listed here are left untouched. " +
+			"Use this resource instead of one `ctrlplane_deployment_variable_value` per override when a variable has many values: " +
+			"refreshing reads the variable once, and applying only writes the entries that changed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the deployment variable, used as the ID of this resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"variable_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The deployment variable ID the values belong to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"values": schema.MapNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "The values of the variable, keyed by a name that identifies each value within this resource.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the deployment variable value.",
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"priority": schema.Int64Attribute{
+							Required:            true,
+							MarkdownDescription: "The priority of the value. Higher priority values take precedence when multiple values match.",
+						},
+						"resource_selector": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "A CEL expression to select which resources this value applies to.",
+							Validators:          celValidators(),
+							PlanModifiers: []planmodifier.String{
+								celNormalized(),
+							},
+						},
+						"literal_value": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "A literal value, encoded according to `value_type`. Conflicts with `reference_value`.",
+						},
+						"value_type": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "How `literal_value` is encoded when sent to the API. One of `string`, `number`, `bool`, or `json`; with `json`, `literal_value` is a JSON-encoded object such as `jsonencode({...})`. Defaults to `string`.",
+						},
+						"reference_value": schema.SingleNestedAttribute{
+							Optional:            true,
+							MarkdownDescription: "A reference value pointing to a property on the matched resource. Conflicts with `literal_value`.",
+							Attributes: map[string]schema.Attribute{
+								"reference": schema.StringAttribute{
+									Required:            true,
+									MarkdownDescription: "The reference key.",
+								},
+								"path": schema.ListAttribute{
+									Required:            true,
+									ElementType:         types.StringType,
+									MarkdownDescription: "The path segments to the value in the referenced resource.",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *DeploymentVariableValuesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var values types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("values"), &values)...)
+	if resp.Diagnostics.HasError() || values.IsNull() || values.IsUnknown() {
+		return
+	}
+
+	var entries map[string]DeploymentVariableValuesEntryModel
+	resp.Diagnostics.Append(values.ElementsAs(ctx, &entries, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for key, entry := range entries {
+		entryPath := path.Root("values").AtMapKey(key)
+
+		hasLiteral := !entry.LiteralValue.IsNull() && !entry.LiteralValue.IsUnknown()
+		hasReference := !entry.ReferenceValue.IsNull() && !entry.ReferenceValue.IsUnknown()
+
+		if hasLiteral && hasReference {
+			resp.Diagnostics.AddAttributeError(
+				entryPath.AtName("literal_value"),
+				"Conflicting value types",
+				"Only one of literal_value or reference_value may be specified, not both.",
+			)
+		}
+		if !hasLiteral && !hasReference && !entry.LiteralValue.IsUnknown() && !entry.ReferenceValue.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				entryPath,
+				"Missing value",
+				"Exactly one of literal_value or reference_value must be specified.",
+			)
+		}
+
+		if entry.ValueType.IsNull() || entry.ValueType.IsUnknown() {
+			continue
+		}
+
+		valueType := entry.ValueType.ValueString()
+		if !slices.Contains(literalValueTypes, valueType) {
+			resp.Diagnostics.AddAttributeError(
+				entryPath.AtName("value_type"),
+				"Invalid value type",
+				fmt.Sprintf("value_type must be one of %s, got %q.", strings.Join(literalValueTypes, ", "), valueType),
+			)
+			continue
+		}
+		if hasReference {
+			resp.Diagnostics.AddAttributeError(
+				entryPath.AtName("value_type"),
+				"Invalid value type",
+				"value_type can only be used with literal_value.",
+			)
+			continue
+		}
+		if hasLiteral {
+			if _, err := literalValueFromDynamicAs(types.DynamicValue(entry.LiteralValue), valueType); err != nil {
+				resp.Diagnostics.AddAttributeError(entryPath.AtName("literal_value"), "Invalid literal value", err.Error())
+			}
+		}
+	}
+}
+
+func (r *DeploymentVariableValuesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DeploymentVariableValuesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	variableID := data.VariableId.ValueString()
+	data.ID = types.StringValue(variableID)
+	created := make(map[string]DeploymentVariableValuesEntryModel, len(data.Values))
+	for _, key := range slices.Sorted(maps.Keys(data.Values)) {
+		entry := data.Values[key]
+		entry.ID = types.StringValue(uuid.NewString())
+		if err := r.upsertValue(ctx, variableID, entry); err != nil {
+			resp.Diagnostics.AddError("Failed to create deployment variable values", fmt.Sprintf("Value %q: %s", key, err.Error()))
+			// Keep the values already written in state, so Terraform
+			// taints the resource and deletes them instead of leaking them.
+			data.Values = created
+			resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+			return
+		}
+		created[key] = entry
+	}
+	data.Values = created
+
+	err := waitForResource(ctx, func() (bool, error) {
+		variable, err := r.getVariable(ctx, variableID)
+		if err != nil || variable == nil {
+			return false, err
+		}
+		present := map[string]bool{}
+		for _, value := range variable.Values {
+			present[value.Id] = true
+		}
+		for _, entry := range data.Values {
+			if !present[entry.ID.ValueString()] {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create deployment variable values", fmt.Sprintf("Values not available after creation: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (r *DeploymentVariableValuesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DeploymentVariableValuesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	variable, err := r.getVariable(ctx, data.VariableId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read deployment variable values",
+			fmt.Sprintf("Failed to read deployment variable with ID '%s': %s", data.VariableId.ValueString(), err.Error()),
+		)
+		return
+	}
+	if variable == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	byID := make(map[string]api.DeploymentVariableValue, len(variable.Values))
+	for _, value := range variable.Values {
+		byID[value.Id] = value
+	}
+
+	// After import there is nothing in state to match against, so every
+	// value of the variable is adopted under its own ID.
+	if data.Values == nil {
+		data.Values = make(map[string]DeploymentVariableValuesEntryModel, len(variable.Values))
+		for _, value := range variable.Values {
+			data.Values[value.Id] = DeploymentVariableValuesEntryModel{
+				ID:             types.StringValue(value.Id),
+				LiteralValue:   types.StringNull(),
+				ValueType:      types.StringNull(),
+				ReferenceValue: types.ObjectNull(referenceValueAttrTypes),
+			}
+		}
+	}
+
+	for key, entry := range data.Values {
+		value, ok := byID[entry.ID.ValueString()]
+		if !ok {
+			delete(data.Values, key)
+			continue
+		}
+
+		refreshed, err := variableValuesEntryFromAPI(ctx, entry, value)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read deployment variable values", fmt.Sprintf("Value %q: %s", key, err.Error()))
+			return
+		}
+		data.Values[key] = refreshed
+	}
+
+	data.ID = types.StringValue(variable.Variable.Id)
+	data.VariableId = types.StringValue(variable.Variable.Id)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeploymentVariableValuesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state DeploymentVariableValuesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	variableID := data.VariableId.ValueString()
+	prior := make(map[string]DeploymentVariableValuesEntryModel, len(state.Values))
+	for _, entry := range state.Values {
+		prior[entry.ID.ValueString()] = entry
+	}
+
+	// current tracks what exists on the server as values are written, so a
+	// failure part way through leaves state matching it.
+	current := maps.Clone(state.Values)
+	failed := func(detail string) {
+		resp.Diagnostics.AddError("Failed to update deployment variable values", detail)
+		state.Values = current
+		resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	}
+
+	kept := map[string]bool{}
+	for _, key := range slices.Sorted(maps.Keys(data.Values)) {
+		entry := data.Values[key]
+		if entry.ID.IsNull() || entry.ID.IsUnknown() || entry.ID.ValueString() == "" {
+			entry.ID = types.StringValue(uuid.NewString())
+		}
+		kept[entry.ID.ValueString()] = true
+
+		if previous, ok := prior[entry.ID.ValueString()]; ok && variableValuesEntryEqual(entry, previous) {
+			data.Values[key] = entry
+			continue
+		}
+		if err := r.upsertValue(ctx, variableID, entry); err != nil {
+			failed(fmt.Sprintf("Value %q: %s", key, err.Error()))
+			return
+		}
+		for name, written := range current {
+			if written.ID.Equal(entry.ID) {
+				delete(current, name)
+			}
+		}
+		current[key] = entry
+		data.Values[key] = entry
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(state.Values)) {
+		id := state.Values[key].ID.ValueString()
+		if kept[id] {
+			continue
+		}
+		if err := r.deleteValue(ctx, id); err != nil {
+			failed(fmt.Sprintf("Deleting value %q: %s", key, err.Error()))
+			return
+		}
+		if current[key].ID.ValueString() == id {
+			delete(current, key)
+		}
+	}
+
+	data.ID = types.StringValue(variableID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (r *DeploymentVariableValuesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DeploymentVariableValuesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(data.Values)) {
+		if err := r.deleteValue(ctx, data.Values[key].ID.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Failed to delete deployment variable values", fmt.Sprintf("Value %q: %s", key, err.Error()))
+			return
+		}
+	}
+}
+
+// getVariable returns the variable with its values, or nil when it does not
+// exist.
+func (r *DeploymentVariableValuesResource) getVariable(ctx context.Context, variableID string) (*api.DeploymentVariableWithValues, error) {
+	variableResp, err := r.workspace.Client.GetDeploymentVariableWithResponse(ctx, r.workspace.ID.String(), variableID)
+	if err != nil {
+		return nil, err
+	}
+	switch variableResp.StatusCode() {
+	case http.StatusOK:
+		if variableResp.JSON200 == nil {
+			return nil, fmt.Errorf("empty response from server")
+		}
+		return variableResp.JSON200, nil
+	case http.StatusNotFound:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("%s", formatResponseError(variableResp.HTTPResponse, variableResp.Body))
+	}
+}
+
+func (r *DeploymentVariableValuesResource) upsertValue(ctx context.Context, variableID string, entry DeploymentVariableValuesEntryModel) error {
+	apiValue, err := valueFromVariableValueModel(DeploymentVariableValueResourceModel{
+		LiteralValue:   variableValuesLiteral(entry.LiteralValue),
+		ValueType:      entry.ValueType,
+		ReferenceValue: entry.ReferenceValue,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to build value: %w", err)
+	}
+
+	var selector *string
+	if cel := normalizeCEL(entry.ResourceSelector); cel != "" {
+		selector = &cel
+	}
+
+	valueResp, err := r.workspace.Client.RequestDeploymentVariableValueUpsertWithResponse(
+		ctx, r.workspace.ID.String(), entry.ID.ValueString(), api.UpsertDeploymentVariableValueRequest{
+			DeploymentVariableId: variableID,
+			Priority:             entry.Priority.ValueInt64(),
+			ResourceSelector:     selector,
+			Value:                *apiValue,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if valueResp.StatusCode() != http.StatusAccepted {
+		return fmt.Errorf("%s", formatResponseError(valueResp.HTTPResponse, valueResp.Body))
+	}
+	return nil
+}
+
+// deleteValue deletes a value, treating one that is already gone as deleted.
+func (r *DeploymentVariableValuesResource) deleteValue(ctx context.Context, valueID string) error {
+	valueResp, err := r.workspace.Client.RequestDeploymentVariableValueDeletionWithResponse(ctx, r.workspace.ID.String(), valueID)
+	if err != nil {
+		return err
+	}
+	switch valueResp.StatusCode() {
+	case http.StatusAccepted, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	default:
+		return fmt.Errorf("%s", formatResponseError(valueResp.HTTPResponse, valueResp.Body))
+	}
+}
+
+// variableValuesEntryFromAPI refreshes entry from the stored value, keeping
+// the configured form of literal_value when it encodes to the stored literal.
+func variableValuesEntryFromAPI(ctx context.Context, entry DeploymentVariableValuesEntryModel, value api.DeploymentVariableValue) (DeploymentVariableValuesEntryModel, error) {
+	entry.ID = types.StringValue(value.Id)
+	entry.Priority = types.Int64Value(value.Priority)
+	if value.ResourceSelector != nil && *value.ResourceSelector != "" {
+		entry.ResourceSelector = types.StringValue(*value.ResourceSelector)
+	} else {
+		entry.ResourceSelector = types.StringNull()
+	}
+
+	var single DeploymentVariableValueResourceModel
+	if diags := setValueOnModel(ctx, &single, value.Value); diags.HasError() {
+		return entry, fmt.Errorf("failed to read value")
+	}
+	entry.ReferenceValue = single.ReferenceValue
+	if !single.ReferenceValue.IsNull() {
+		entry.LiteralValue = types.StringNull()
+		entry.ValueType = types.StringNull()
+		return entry, nil
+	}
+
+	stored, err := value.Value.AsLiteralValue()
+	if err != nil {
+		entry.LiteralValue = types.StringNull()
+		return entry, nil
+	}
+	if !entry.LiteralValue.IsNull() {
+		expected, err := literalValueFromDynamicAs(variableValuesLiteral(entry.LiteralValue), entry.ValueType.ValueString())
+		if err == nil && expected != nil && jsonEquivalent(expected, stored) {
+			return entry, nil
+		}
+	}
+
+	literal, valueType, err := variableValuesLiteralString(stored)
+	if err != nil {
+		return entry, err
+	}
+	entry.LiteralValue = types.StringValue(literal)
+	if valueType == "string" && entry.ValueType.IsNull() {
+		return entry, nil
+	}
+	entry.ValueType = types.StringValue(valueType)
+	return entry, nil
+}
+
+// variableValuesLiteralString renders a stored literal as the string form
+// accepted by literal_value, along with the value_type that reproduces it.
+func variableValuesLiteralString(literal api.LiteralValue) (string, string, error) {
+	encoded, err := json.Marshal(literal)
+	if err != nil {
+		return "", "", err
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return "", "", err
+	}
+	switch v := decoded.(type) {
+	case string:
+		return v, "string", nil
+	case bool:
+		return string(encoded), "bool", nil
+	case float64:
+		return string(encoded), "number", nil
+	default:
+		return string(encoded), "json", nil
+	}
+}
+
+func variableValuesLiteral(value types.String) types.Dynamic {
+	if value.IsNull() {
+		return types.DynamicNull()
+	}
+	if value.IsUnknown() {
+		return types.DynamicUnknown()
+	}
+	return types.DynamicValue(value)
+}
+
+func variableValuesEntryEqual(a, b DeploymentVariableValuesEntryModel) bool {
+	return a.Priority.Equal(b.Priority) &&
+		normalizeCEL(a.ResourceSelector) == normalizeCEL(b.ResourceSelector) &&
+		a.LiteralValue.Equal(b.LiteralValue) &&
+		a.ValueType.Equal(b.ValueType) &&
+		a.ReferenceValue.Equal(b.ReferenceValue)
+}
@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2021, 2026
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ctrlplanedev/terraform-provider-ctrlplane/internal/api"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccDeploymentVariableValuesResource(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-var-values-%d", time.Now().UnixNano())
+	var prodValueID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentVariableValuesResourceConfig(name, `
+    default = {
+      priority      = 0
+      literal_value = "1"
+      value_type    = "number"
+    }
+    prod = {
+      priority          = 10
+      resource_selector = "resource.metadata['env'] == 'prod'"
+      literal_value     = "3"
+      value_type        = "number"
+    }
+`),
+				Check: func(s *terraform.State) error {
+					prodValueID = s.RootModule().Resources["ctrlplane_deployment_variable_values.test"].Primary.Attributes["values.prod.id"]
+					if prodValueID == "" {
+						return fmt.Errorf("values.prod.id is not set")
+					}
+					return nil
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"ctrlplane_deployment_variable_values.test",
+						tfjsonpath.New("values").AtMapKey("default").AtMapKey("id"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"ctrlplane_deployment_variable_values.test",
+						tfjsonpath.New("values").AtMapKey("prod").AtMapKey("literal_value"),
+						knownvalue.StringExact("3"),
+					),
+				},
+			},
+			{
+				Config: testAccDeploymentVariableValuesResourceConfig(name, `
+    default = {
+      priority      = 0
+      literal_value = "2"
+      value_type    = "number"
+    }
+    region = {
+      priority = 5
+      reference_value = {
+        reference = "region"
+        path      = ["metadata", "region"]
+      }
+    }
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"ctrlplane_deployment_variable_values.test",
+						tfjsonpath.New("values").AtMapKey("default").AtMapKey("literal_value"),
+						knownvalue.StringExact("2"),
+					),
+					statecheck.ExpectKnownValue(
+						"ctrlplane_deployment_variable_values.test",
+						tfjsonpath.New("values").AtMapKey("region").AtMapKey("reference_value").AtMapKey("reference"),
+						knownvalue.StringExact("region"),
+					),
+				},
+				// prod was removed from the map, so its value must be gone
+				// from the server, not just from state.
+				Check: func(s *terraform.State) error {
+					return testAccCheckDeploymentVariableValueDeleted(prodValueID)
+				},
+			},
+			{
+				ResourceName: "ctrlplane_deployment_variable_values.test",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					attrs := s.RootModule().Resources["ctrlplane_deployment_variable_values.test"].Primary.Attributes
+					return fmt.Sprintf("%s/default=%s,region=%s", attrs["variable_id"], attrs["values.default.id"], attrs["values.region.id"]), nil
+				},
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccCheckDeploymentVariableValueDeleted waits for the value with valueID
+// to be deleted on the server.
+func testAccCheckDeploymentVariableValueDeleted(valueID string) error {
+	ctx := context.Background()
+	client, err := api.NewAPIKeyClientWithResponses(os.Getenv("CTRLPLANE_URL"), os.Getenv("CTRLPLANE_API_KEY"))
+	if err != nil {
+		return err
+	}
+	workspaceID := client.GetWorkspaceID(ctx, os.Getenv("CTRLPLANE_WORKSPACE")).String()
+
+	return waitForResource(ctx, func() (bool, error) {
+		valueResp, err := client.GetDeploymentVariableValueWithResponse(ctx, workspaceID, valueID)
+		if err != nil {
+			return false, err
+		}
+		return valueResp.StatusCode() == http.StatusNotFound, nil
+	})
+}
+
+func testAccDeploymentVariableValuesResourceConfig(name, values string) string {
+	return fmt.Sprintf(`
+%s
+resource "ctrlplane_deployment" "test" {
+  name              = %q
+  resource_selector = "resource.name == '%s'"
+}
+
+resource "ctrlplane_deployment_variable" "test" {
+  deployment_id = ctrlplane_deployment.test.id
+  key           = "replicas"
+}
+
+resource "ctrlplane_deployment_variable_values" "test" {
+  variable_id = ctrlplane_deployment_variable.test.id
+
+  values = {%s  }
+}
+`, testAccProviderConfig(), name, name, values)
+}
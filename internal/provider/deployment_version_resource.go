@@ -0,0 +1,407 @@
+// Copyright IBM Corp. 2021, 2026
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ctrlplanedev/terraform-provider-ctrlplane/internal/api"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &DeploymentVersionResource{}
+var _ resource.ResourceWithImportState = &DeploymentVersionResource{}
+var _ resource.ResourceWithConfigure = &DeploymentVersionResource{}
+var _ resource.ResourceWithModifyPlan = &DeploymentVersionResource{}
+var _ resource.ResourceWithValidateConfig = &DeploymentVersionResource{}
+
+var deploymentVersionStatuses = []api.DeploymentVersionStatus{
+	api.DeploymentVersionStatusBuilding,
+	api.DeploymentVersionStatusReady,
+	api.DeploymentVersionStatusFailed,
+	api.DeploymentVersionStatusRejected,
+	api.DeploymentVersionStatusUnspecified,
+}
+
+func NewDeploymentVersionResource() resource.Resource {
+	return &DeploymentVersionResource{}
+}
+
+type DeploymentVersionResource struct {
+	workspace *api.WorkspaceClient
+}
+
+type DeploymentVersionResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	DeploymentId   types.String `tfsdk:"deployment_id"`
+	Tag            types.String `tfsdk:"tag"`
+	Name           types.String `tfsdk:"name"`
+	Status         types.String `tfsdk:"status"`
+	Config         types.String `tfsdk:"config"`
+	JobAgentConfig types.String `tfsdk:"job_agent_config"`
+	Metadata       types.Map    `tfsdk:"metadata"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+}
+
+func (r *DeploymentVersionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment_version"
+}
+
+func (r *DeploymentVersionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	recordPlannedChange(ctx, r.workspace, "ctrlplane_deployment_version", req, resp)
+}
+
+func (r *DeploymentVersionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Import ID must be in the format: deployment_id/version_id",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deployment_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
+
+func (r *DeploymentVersionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	workspace, ok := req.ProviderData.(*api.WorkspaceClient)
+	if !ok {
+		resp.Diagnostics.AddError("Invalid provider data", "The provider data is not a *api.WorkspaceClient")
+		return
+	}
+
+	r.workspace = workspace
+}
+
+func (r *DeploymentVersionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Registers a version (release) of a deployment in Ctrlplane. " +
+			"The API has no endpoint for deleting versions, so destroying this resource only removes it from Terraform state; " +
+			"set status to \"rejected\" first to stop the version from being deployed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the deployment version",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deployment_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the deployment this version belongs to",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tag": schema.StringAttribute{
+				Required:    true,
+				Description: "The version tag, e.g. a semantic version or image tag",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Display name of the version. Defaults to the tag.",
+			},
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The version status: building, ready, failed, rejected, or unspecified. Defaults to ready.",
+				Default:     stringdefault.StaticString(string(api.DeploymentVersionStatusReady)),
+			},
+			"config": schema.StringAttribute{
+				Optional:    true,
+				Description: "JSON-encoded configuration for the version. Use jsonencode() to set this value.",
+			},
+			"job_agent_config": schema.StringAttribute{
+				Optional:    true,
+				Description: "JSON-encoded job agent configuration overrides for the version. Use jsonencode() to set this value.",
+			},
+			"metadata": schema.MapAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The metadata of the version",
+				ElementType: types.StringType,
+				Default: func() defaults.Map {
+					empty, _ := types.MapValueFrom(context.Background(), types.StringType, map[string]string{})
+					return mapdefault.StaticValue(empty)
+				}(),
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the version was created",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DeploymentVersionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DeploymentVersionResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Status.IsNull() && !data.Status.IsUnknown() {
+		if !isDeploymentVersionStatus(data.Status.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("status"),
+				"Invalid deployment version status",
+				fmt.Sprintf("status must be one of building, ready, failed, rejected, or unspecified, got %q.", data.Status.ValueString()),
+			)
+		}
+	}
+
+	for _, attribute := range []struct {
+		name  string
+		value types.String
+	}{
+		{"config", data.Config},
+		{"job_agent_config", data.JobAgentConfig},
+	} {
+		if _, err := configFromJSONString(attribute.value); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(attribute.name), "Invalid JSON", err.Error())
+		}
+	}
+}
+
+func (r *DeploymentVersionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DeploymentVersionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := configFromJSONString(data.Config)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid config", err.Error())
+		return
+	}
+	jobAgentConfig, err := configFromJSONString(data.JobAgentConfig)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid job_agent_config", err.Error())
+		return
+	}
+
+	name := data.Tag.ValueString()
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
+		name = data.Name.ValueString()
+	}
+
+	requestBody := api.CreateDeploymentVersionJSONRequestBody{
+		Tag:            data.Tag.ValueString(),
+		Name:           name,
+		Status:         api.DeploymentVersionStatus(data.Status.ValueString()),
+		Config:         &config,
+		JobAgentConfig: &jobAgentConfig,
+		Metadata:       stringMapPointer(data.Metadata),
+	}
+
+	versionResp, err := r.workspace.Client.CreateDeploymentVersionWithResponse(
+		ctx, r.workspace.ID.String(), data.DeploymentId.ValueString(), requestBody,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create deployment version", err.Error())
+		return
+	}
+
+	if versionResp.StatusCode() != http.StatusOK && versionResp.StatusCode() != http.StatusCreated {
+		addResponseError(ctx, &resp.Diagnostics, "Failed to create deployment version", req.Plan, versionResp.HTTPResponse, versionResp.Body)
+		return
+	}
+
+	if versionResp.JSON200 == nil || versionResp.JSON200.Id == "" {
+		resp.Diagnostics.AddError("Failed to create deployment version", "Empty version ID in response")
+		return
+	}
+
+	version := versionResp.JSON200
+	data.ID = types.StringValue(version.Id)
+	data.Name = types.StringValue(version.Name)
+	data.CreatedAt = types.StringValue(version.CreatedAt.UTC().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (r *DeploymentVersionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DeploymentVersionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	version, found, err := r.findVersion(ctx, data.DeploymentId.ValueString(), data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read deployment version",
+			fmt.Sprintf("Failed to read deployment version with ID '%s': %s", data.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.StringValue(version.Id)
+	data.DeploymentId = types.StringValue(version.DeploymentId)
+	data.Tag = types.StringValue(version.Tag)
+	data.Name = types.StringValue(version.Name)
+	data.Status = types.StringValue(string(version.Status))
+	data.Config = preserveJSONString(data.Config, version.Config)
+	data.JobAgentConfig = preserveJSONString(data.JobAgentConfig, version.JobAgentConfig)
+	if version.Metadata != nil {
+		data.Metadata = stringMapValue(version.Metadata)
+	} else {
+		data.Metadata = stringMapValue(&map[string]string{})
+	}
+	data.CreatedAt = types.StringValue(version.CreatedAt.UTC().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeploymentVersionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DeploymentVersionResourceModel
+	var state DeploymentVersionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = state.ID
+
+	config, err := configFromJSONString(data.Config)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid config", err.Error())
+		return
+	}
+	jobAgentConfig, err := configFromJSONString(data.JobAgentConfig)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid job_agent_config", err.Error())
+		return
+	}
+
+	tag := data.Tag.ValueString()
+	name := tag
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
+		name = data.Name.ValueString()
+	}
+	status := api.DeploymentVersionStatus(data.Status.ValueString())
+
+	requestBody := api.RequestDeploymentVersionUpdateJSONRequestBody{
+		Tag:            &tag,
+		Name:           &name,
+		Status:         &status,
+		Config:         &config,
+		JobAgentConfig: &jobAgentConfig,
+		Metadata:       stringMapPointer(data.Metadata),
+	}
+
+	versionResp, err := r.workspace.Client.RequestDeploymentVersionUpdateWithResponse(
+		ctx, r.workspace.ID.String(), data.ID.ValueString(), requestBody,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update deployment version",
+			fmt.Sprintf("Failed to update deployment version with ID '%s': %s", data.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	if versionResp.StatusCode() != http.StatusAccepted {
+		addResponseError(ctx, &resp.Diagnostics, "Failed to update deployment version", req.Plan, versionResp.HTTPResponse, versionResp.Body)
+		return
+	}
+
+	data.Name = types.StringValue(name)
+	data.CreatedAt = state.CreatedAt
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (r *DeploymentVersionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.AddWarning(
+		"Deployment version not deleted",
+		"The Ctrlplane API does not support deleting deployment versions. The version was removed from Terraform state but still exists in the workspace.",
+	)
+}
+
+// findVersion pages through the deployment's versions looking for versionID.
+// A missing deployment is reported as not found.
+func (r *DeploymentVersionResource) findVersion(ctx context.Context, deploymentID, versionID string) (*api.DeploymentVersionWithDependencies, bool, error) {
+	versions := api.Paginate(ctx, func(ctx context.Context, limit, offset int) (api.Page[api.DeploymentVersionWithDependencies], error) {
+		listResp, err := r.workspace.Client.ListDeploymentVersionsWithResponse(
+			ctx, r.workspace.ID.String(), deploymentID, &api.ListDeploymentVersionsParams{
+				Limit:  &limit,
+				Offset: &offset,
+			},
+		)
+		if err != nil {
+			return api.Page[api.DeploymentVersionWithDependencies]{}, err
+		}
+
+		switch listResp.StatusCode() {
+		case http.StatusOK:
+			if listResp.JSON200 == nil {
+				return api.Page[api.DeploymentVersionWithDependencies]{}, fmt.Errorf("empty response from server")
+			}
+			return api.Page[api.DeploymentVersionWithDependencies]{Items: listResp.JSON200.Items, Total: listResp.JSON200.Total}, nil
+		case http.StatusNotFound:
+			return api.Page[api.DeploymentVersionWithDependencies]{}, nil
+		default:
+			return api.Page[api.DeploymentVersionWithDependencies]{}, fmt.Errorf("%s", formatResponseError(listResp.HTTPResponse, listResp.Body))
+		}
+	})
+
+	for version, err := range versions {
+		if err != nil {
+			return nil, false, err
+		}
+		if version.Id == versionID {
+			return &version, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+// preserveJSONString keeps the configured JSON text when it decodes to the same
+// object the API returned, so formatting differences do not show up as drift.
+func preserveJSONString(prior types.String, remote map[string]interface{}) types.String {
+	if !prior.IsNull() && !prior.IsUnknown() {
+		if configured, err := configFromJSONString(prior); err == nil && jsonEquivalent(configured, remote) {
+			return prior
+		}
+	}
+	return configToJSONString(remote)
+}
+
+func isDeploymentVersionStatus(value string) bool {
+	for _, status := range deploymentVersionStatuses {
+		if string(status) == value {
+			return true
+		}
+	}
+	return false
+}
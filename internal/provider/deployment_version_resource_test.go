@@ -0,0 +1,101 @@
+// Copyright IBM Corp. 2021, 2026
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccDeploymentVersionResource(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-dv-%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentVersionResourceConfig(name, "v1.0.0", "ready"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"ctrlplane_deployment_version.test",
+						tfjsonpath.New("id"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"ctrlplane_deployment_version.test",
+						tfjsonpath.New("tag"),
+						knownvalue.StringExact("v1.0.0"),
+					),
+					statecheck.ExpectKnownValue(
+						"ctrlplane_deployment_version.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact("v1.0.0"),
+					),
+					statecheck.ExpectKnownValue(
+						"ctrlplane_deployment_version.test",
+						tfjsonpath.New("status"),
+						knownvalue.StringExact("ready"),
+					),
+					statecheck.ExpectKnownValue(
+						"ctrlplane_deployment_version.test",
+						tfjsonpath.New("metadata").AtMapKey("commit"),
+						knownvalue.StringExact("abc123"),
+					),
+				},
+			},
+			{
+				Config: testAccDeploymentVersionResourceConfig(name, "v1.0.0", "rejected"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"ctrlplane_deployment_version.test",
+						tfjsonpath.New("status"),
+						knownvalue.StringExact("rejected"),
+					),
+				},
+			},
+			{
+				ResourceName:      "ctrlplane_deployment_version.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["ctrlplane_deployment_version.test"]
+					if !ok {
+						return "", fmt.Errorf("deployment version not found in state")
+					}
+					return rs.Primary.Attributes["deployment_id"] + "/" + rs.Primary.ID, nil
+				},
+			},
+		},
+	})
+}
+
+func testAccDeploymentVersionResourceConfig(name, tag, status string) string {
+	return fmt.Sprintf(`
+%s
+resource "ctrlplane_deployment" "test" {
+  name = %q
+}
+
+resource "ctrlplane_deployment_version" "test" {
+  deployment_id = ctrlplane_deployment.test.id
+  tag           = %q
+  status        = %q
+
+  config = jsonencode({
+    image = "example/app:%s"
+  })
+
+  metadata = {
+    commit = "abc123"
+  }
+}
+`, testAccProviderConfig(), name, tag, status, tag)
+}
@@ -0,0 +1,210 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/ctrlplanedev/terraform-provider-ctrlplane/internal/api"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DeploymentsDataSource{}
+var _ datasource.DataSourceWithConfigure = &DeploymentsDataSource{}
+
+func NewDeploymentsDataSource() datasource.DataSource {
+	return &DeploymentsDataSource{}
+}
+
+type DeploymentsDataSource struct {
+	workspace *api.WorkspaceClient
+}
+
+type DeploymentsDataSourceModel struct {
+	Selector    types.String                  `tfsdk:"selector"`
+	Name        types.String                  `tfsdk:"name"`
+	Slug        types.String                  `tfsdk:"slug"`
+	Metadata    map[string]string             `tfsdk:"metadata"`
+	Deployments []DeploymentsDataSourceResult `tfsdk:"deployments"`
+}
+
+type DeploymentsDataSourceResult struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Slug             types.String `tfsdk:"slug"`
+	Description      types.String `tfsdk:"description"`
+	ResourceSelector types.String `tfsdk:"resource_selector"`
+	JobAgentSelector types.String `tfsdk:"job_agent_selector"`
+	JobAgentConfig   types.String `tfsdk:"job_agent_config"`
+	Metadata         types.Map    `tfsdk:"metadata"`
+	SystemIds        []string     `tfsdk:"system_ids"`
+}
+
+func (d *DeploymentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployments"
+}
+
+func (d *DeploymentsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List deployments in the configured workspace, filtered by CEL selector, name, slug, or metadata. All filters are combined.",
+		Attributes: map[string]schema.Attribute{
+			"selector": schema.StringAttribute{
+				Optional:    true,
+				Description: "CEL expression evaluated by the API against each deployment, e.g. deployment.metadata['team'] == 'payments'",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return deployments with this exact name",
+			},
+			"slug": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return deployments with this exact slug",
+			},
+			"metadata": schema.MapAttribute{
+				Optional:    true,
+				Description: "Only return deployments whose metadata contains all of these key/value pairs",
+				ElementType: types.StringType,
+			},
+			"deployments": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The matching deployments, ordered as returned by the API",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the deployment",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the deployment",
+						},
+						"slug": schema.StringAttribute{
+							Computed:    true,
+							Description: "The slug of the deployment",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "The description of the deployment",
+						},
+						"resource_selector": schema.StringAttribute{
+							Computed:    true,
+							Description: "CEL expression used to select resources",
+						},
+						"job_agent_selector": schema.StringAttribute{
+							Computed:    true,
+							Description: "CEL expression used to match a job agent",
+						},
+						"job_agent_config": schema.StringAttribute{
+							Computed:    true,
+							Sensitive:   true,
+							Description: "The job agent configuration of the deployment, JSON encoded",
+						},
+						"metadata": schema.MapAttribute{
+							Computed:    true,
+							Description: "The metadata of the deployment",
+							ElementType: types.StringType,
+						},
+						"system_ids": schema.ListAttribute{
+							Computed:    true,
+							Description: "IDs of the systems the deployment is linked to",
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DeploymentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	workspace, ok := req.ProviderData.(*api.WorkspaceClient)
+	if !ok {
+		resp.Diagnostics.AddError("Invalid provider data", "The provider data is not a *api.WorkspaceClient")
+		return
+	}
+
+	d.workspace = workspace
+}
+
+func (d *DeploymentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DeploymentsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var cel *string
+	if selector := normalizeCEL(data.Selector); selector != "" {
+		cel = &selector
+	}
+
+	deployments, err := listDeployments(ctx, d.workspace, cel)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list deployments", err.Error())
+		return
+	}
+
+	data.Deployments = []DeploymentsDataSourceResult{}
+	for _, item := range deployments {
+		dep := item.Deployment
+		if !data.Name.IsNull() && dep.Name != data.Name.ValueString() {
+			continue
+		}
+		if !data.Slug.IsNull() && dep.Slug != data.Slug.ValueString() {
+			continue
+		}
+		if !metadataContains(dep.Metadata, data.Metadata) {
+			continue
+		}
+
+		jobAgentConfig, err := jobAgentConfigValue(dep.JobAgentConfig)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to list deployments", err.Error())
+			return
+		}
+
+		result := DeploymentsDataSourceResult{
+			ID:               types.StringValue(dep.Id),
+			Name:             types.StringValue(dep.Name),
+			Slug:             types.StringValue(dep.Slug),
+			Description:      descriptionValue(dep.Description),
+			ResourceSelector: types.StringNull(),
+			JobAgentSelector: types.StringNull(),
+			JobAgentConfig:   jobAgentConfig,
+			Metadata:         stringMapValue(dep.Metadata),
+			SystemIds:        make([]string, 0, len(item.Systems)),
+		}
+		if dep.ResourceSelector != nil && *dep.ResourceSelector != "" {
+			result.ResourceSelector = types.StringValue(*dep.ResourceSelector)
+		}
+		if dep.JobAgentSelector != "" {
+			result.JobAgentSelector = types.StringValue(dep.JobAgentSelector)
+		}
+		for _, system := range item.Systems {
+			result.SystemIds = append(result.SystemIds, system.Id)
+		}
+
+		data.Deployments = append(data.Deployments, result)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func metadataContains(metadata *map[string]string, want map[string]string) bool {
+	for key, value := range want {
+		if metadata == nil {
+			return false
+		}
+		if got, ok := (*metadata)[key]; !ok || got != value {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,163 @@
+// Copyright IBM Corp. 2021, 2026
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = DurationType{}
+var _ basetypes.StringValuableWithSemanticEquals = DurationValue{}
+var _ xattr.ValidateableAttribute = DurationValue{}
+
+// DurationType is a string attribute holding a Go duration such as "90m" or
+// "1h30m". The API stores durations as whole seconds, so values that describe
+// the same number of seconds are treated as equal and the configured spelling
+// is kept in state.
+type DurationType struct {
+	basetypes.StringType
+}
+
+func (t DurationType) String() string {
+	return "DurationType"
+}
+
+func (t DurationType) Equal(o attr.Type) bool {
+	other, ok := o.(DurationType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t DurationType) ValueType(ctx context.Context) attr.Value {
+	return DurationValue{}
+}
+
+func (t DurationType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return DurationValue{StringValue: in}, nil
+}
+
+func (t DurationType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return DurationValue{StringValue: stringValue}, nil
+}
+
+// DurationValue is the value type of DurationType.
+type DurationValue struct {
+	basetypes.StringValue
+}
+
+func NewDurationValue(value string) DurationValue {
+	return DurationValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func NewDurationNull() DurationValue {
+	return DurationValue{StringValue: basetypes.NewStringNull()}
+}
+
+func NewDurationUnknown() DurationValue {
+	return DurationValue{StringValue: basetypes.NewStringUnknown()}
+}
+
+// durationFromSeconds builds the value read back from the API using the
+// shortest whole unit, e.g. 5400 becomes "90m".
+func durationFromSeconds(seconds int64) DurationValue {
+	return NewDurationValue(formatDuration(time.Duration(seconds) * time.Second))
+}
+
+func (v DurationValue) Type(ctx context.Context) attr.Type {
+	return DurationType{}
+}
+
+func (v DurationValue) Equal(o attr.Value) bool {
+	other, ok := o.(DurationValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals reports whether both values parse to the same number
+// of seconds.
+func (v DurationValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(DurationValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got %T. Please report this to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	prior, err := parseDurationSeconds(v)
+	if err != nil {
+		return false, diags
+	}
+	current, err := parseDurationSeconds(newValue)
+	if err != nil {
+		return false, diags
+	}
+	return prior == current, diags
+}
+
+func (v DurationValue) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if _, err := parseDurationSeconds(v); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid duration", err.Error())
+	}
+}
+
+// formatDuration renders value in the largest unit that divides it evenly.
+func formatDuration(value time.Duration) string {
+	if value%time.Hour == 0 {
+		return fmt.Sprintf("%dh", int64(value/time.Hour))
+	}
+	if value%time.Minute == 0 {
+		return fmt.Sprintf("%dm", int64(value/time.Minute))
+	}
+	if value%time.Second == 0 {
+		return fmt.Sprintf("%ds", int64(value/time.Second))
+	}
+	return value.String()
+}
+
+func parseDurationSeconds(value DurationValue) (int64, error) {
+	if value.IsNull() || value.IsUnknown() {
+		return 0, fmt.Errorf("duration must be set")
+	}
+	raw := value.ValueString()
+	duration, err := time.ParseDuration(raw)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q", raw)
+	}
+	if duration < 0 {
+		return 0, fmt.Errorf("duration must be non-negative")
+	}
+	if duration%time.Second != 0 {
+		return 0, fmt.Errorf("duration %q must be a whole number of seconds", raw)
+	}
+	return int64(duration.Seconds()), nil
+}
@@ -31,6 +31,8 @@ type EnvironmentDataSourceModel struct {
 	Description      types.String `tfsdk:"description"`
 	ResourceSelector types.String `tfsdk:"resource_selector"`
 	Metadata         types.Map    `tfsdk:"metadata"`
+	AppURL           types.String `tfsdk:"app_url"`
+	EntityURL        types.String `tfsdk:"entity_url"`
 }
 
 func (d *EnvironmentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,6 +43,14 @@ func (d *EnvironmentDataSource) Schema(ctx context.Context, req datasource.Schem
 	resp.Schema = schema.Schema{
 		Description: "Fetch an existing environment by name within the configured workspace.",
 		Attributes: map[string]schema.Attribute{
+			"app_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "Link to the workspace in the Ctrlplane UI",
+			},
+			"entity_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "Link to this environment in the Ctrlplane UI",
+			},
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "The ID of the environment",
@@ -107,7 +117,7 @@ func (d *EnvironmentDataSource) Read(ctx context.Context, req datasource.ReadReq
 	}
 
 	if envResp.StatusCode() != http.StatusOK || envResp.JSON200 == nil {
-		resp.Diagnostics.AddError("Failed to read environment", formatResponseError(envResp.StatusCode(), envResp.Body))
+		resp.Diagnostics.AddError("Failed to read environment", formatResponseError(envResp.HTTPResponse, envResp.Body))
 		return
 	}
 
@@ -122,5 +132,23 @@ func (d *EnvironmentDataSource) Read(ctx context.Context, req datasource.ReadReq
 		data.ResourceSelector = types.StringNull()
 	}
 
+	data.AppURL, data.EntityURL = entityURLs(d.workspace, "environments", data.ID)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+func environmentPages(workspace *api.WorkspaceClient) api.PageFunc[api.Environment] {
+	return func(ctx context.Context, limit, offset int) (api.Page[api.Environment], error) {
+		listResp, err := workspace.Client.ListEnvironmentsWithResponse(ctx, workspace.ID.String(), &api.ListEnvironmentsParams{
+			Limit:  &limit,
+			Offset: &offset,
+		})
+		if err != nil {
+			return api.Page[api.Environment]{}, fmt.Errorf("failed to list environments: %w", err)
+		}
+		if listResp.StatusCode() != http.StatusOK || listResp.JSON200 == nil {
+			return api.Page[api.Environment]{}, fmt.Errorf("%s", formatResponseError(listResp.HTTPResponse, listResp.Body))
+		}
+		return api.Page[api.Environment]{Items: listResp.JSON200.Items, Total: listResp.JSON200.Total}, nil
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2021, 2026
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/ctrlplanedev/terraform-provider-ctrlplane/internal/api"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// maxMatchedResourcesSample bounds sample_matched_resources_limit so a
+// sample stays readable in plan output.
+const maxMatchedResourcesSample = 100
+
+var matchedResourceAttrTypes = map[string]attr.Type{
+	"identifier": types.StringType,
+	"name":       types.StringType,
+	"kind":       types.StringType,
+}
+
+var matchedResourceObjectType = types.ObjectType{AttrTypes: matchedResourceAttrTypes}
+
+// sampleMatchedResources returns up to limit resources matched by selector,
+// in the order the API lists them. A null limit disables sampling, and an
+// environment without a selector matches no resources.
+func sampleMatchedResources(ctx context.Context, workspace *api.WorkspaceClient, selector types.String, limit types.Int64) (types.List, error) {
+	if limit.IsNull() {
+		return types.ListNull(matchedResourceObjectType), nil
+	}
+
+	elems := []attr.Value{}
+	cel := normalizeCEL(selector)
+	if cel != "" {
+		n := int(limit.ValueInt64())
+		resourcesResp, err := workspace.Client.GetAllResourcesWithResponse(ctx, workspace.ID.String(), &api.GetAllResourcesParams{
+			Limit: &n,
+			Cel:   &cel,
+		})
+		if err != nil {
+			return types.ListNull(matchedResourceObjectType), err
+		}
+		if resourcesResp.StatusCode() != http.StatusOK || resourcesResp.JSON200 == nil {
+			return types.ListNull(matchedResourceObjectType), errors.New(formatResponseError(resourcesResp.HTTPResponse, resourcesResp.Body))
+		}
+
+		for _, item := range resourcesResp.JSON200.Items {
+			if len(elems) == n {
+				break
+			}
+			obj, diags := types.ObjectValue(matchedResourceAttrTypes, map[string]attr.Value{
+				"identifier": types.StringValue(item.Identifier),
+				"name":       types.StringValue(item.Name),
+				"kind":       types.StringValue(item.Kind),
+			})
+			if diags.HasError() {
+				return types.ListNull(matchedResourceObjectType), fmt.Errorf("failed to build matched resource %q", item.Identifier)
+			}
+			elems = append(elems, obj)
+		}
+	}
+
+	list, diags := types.ListValue(matchedResourceObjectType, elems)
+	if diags.HasError() {
+		return types.ListNull(matchedResourceObjectType), errors.New("failed to build sample_matched_resources")
+	}
+	return list, nil
+}
+
+// refreshMatchedResources resamples sample_matched_resources for data, or
+// only when it is unknown when onlyUnknown is set. Sampling never decides
+// whether the environment is recorded in state: a failure is reported as a
+// warning and leaves the sample as it was, or null when it was unknown, until
+// the next refresh samples again.
+func refreshMatchedResources(ctx context.Context, workspace *api.WorkspaceClient, data *EnvironmentResourceModel, onlyUnknown bool, diags *diag.Diagnostics) {
+	if onlyUnknown && !data.SampleMatchedResources.IsUnknown() {
+		return
+	}
+
+	sample, err := sampleMatchedResources(ctx, workspace, data.ResourceSelector, data.SampleMatchedResourcesLimit)
+	if err != nil {
+		diags.AddAttributeWarning(
+			path.Root("sample_matched_resources"),
+			"Failed to sample matched resources",
+			fmt.Sprintf("The environment is unaffected, but sample_matched_resources could not be refreshed and is sampled again on the next refresh: %s", err.Error()),
+		)
+		if data.SampleMatchedResources.IsUnknown() {
+			data.SampleMatchedResources = types.ListNull(matchedResourceObjectType)
+		}
+		return
+	}
+	data.SampleMatchedResources = sample
+}
@@ -0,0 +1,96 @@
+// Copyright IBM Corp. 2021, 2026
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ctrlplanedev/terraform-provider-ctrlplane/internal/api"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func newMatchedResourcesTestWorkspace(t *testing.T, status int, body string) *api.WorkspaceClient {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := api.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &api.WorkspaceClient{ID: uuid.New(), Client: client}
+}
+
+func TestRefreshMatchedResources(t *testing.T) {
+	prior, _ := types.ListValue(matchedResourceObjectType, []attr.Value{})
+
+	cases := map[string]struct {
+		status      int
+		body        string
+		sample      types.List
+		onlyUnknown bool
+		wantNull    bool
+		wantLen     int
+		wantWarning bool
+	}{
+		"unknown sampled": {
+			status: http.StatusOK,
+			body:   `{"items":[{"identifier":"a","name":"a","kind":"Pod"}],"total":1}`,
+			sample: types.ListUnknown(matchedResourceObjectType), onlyUnknown: true,
+			wantLen: 1,
+		},
+		"known kept after write": {
+			status: http.StatusInternalServerError,
+			sample: prior, onlyUnknown: true,
+		},
+		"failure after write leaves null": {
+			status: http.StatusInternalServerError, body: `{"error":"boom"}`,
+			sample: types.ListUnknown(matchedResourceObjectType), onlyUnknown: true,
+			wantNull: true, wantWarning: true,
+		},
+		"failure on refresh keeps prior": {
+			status: http.StatusInternalServerError, body: `{"error":"boom"}`,
+			sample:      prior,
+			wantWarning: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			data := EnvironmentResourceModel{
+				ResourceSelector:            types.StringValue("resource.kind == 'Pod'"),
+				SampleMatchedResourcesLimit: types.Int64Value(5),
+				SampleMatchedResources:      tc.sample,
+			}
+			var diags diag.Diagnostics
+			refreshMatchedResources(context.Background(), newMatchedResourcesTestWorkspace(t, tc.status, tc.body), &data, tc.onlyUnknown, &diags)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if got := diags.WarningsCount() > 0; got != tc.wantWarning {
+				t.Errorf("warning = %t, want %t: %v", got, tc.wantWarning, diags)
+			}
+			got := data.SampleMatchedResources
+			if got.IsUnknown() {
+				t.Fatalf("sample is still unknown")
+			}
+			if got.IsNull() != tc.wantNull {
+				t.Fatalf("sample null = %t, want %t", got.IsNull(), tc.wantNull)
+			}
+			if !tc.wantNull && len(got.Elements()) != tc.wantLen {
+				t.Errorf("sample has %d elements, want %d", len(got.Elements()), tc.wantLen)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &EnvironmentProgressionMatrixDataSource{}
+
+func NewEnvironmentProgressionMatrixDataSource() datasource.DataSource {
+	return &EnvironmentProgressionMatrixDataSource{}
+}
+
+// EnvironmentProgressionMatrixDataSource turns an ordered list of environments
+// into the selectors for one environment_progression rule per step. It makes
+// no API requests.
+type EnvironmentProgressionMatrixDataSource struct{}
+
+type EnvironmentProgressionMatrixDataSourceModel struct {
+	EnvironmentIds []types.String                               `tfsdk:"environment_ids"`
+	Progressions   map[string]EnvironmentProgressionMatrixEntry `tfsdk:"progressions"`
+}
+
+type EnvironmentProgressionMatrixEntry struct {
+	Position                     types.Int64  `tfsdk:"position"`
+	EnvironmentId                types.String `tfsdk:"environment_id"`
+	DependsOnEnvironmentId       types.String `tfsdk:"depends_on_environment_id"`
+	Selector                     types.String `tfsdk:"selector"`
+	DependsOnEnvironmentSelector types.String `tfsdk:"depends_on_environment_selector"`
+}
+
+func (d *EnvironmentProgressionMatrixDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment_progression_matrix"
+}
+
+func (d *EnvironmentProgressionMatrixDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Build the selectors for a chain of environment progression rules from an ordered list of environments. " +
+			"Each environment after the first gets an entry that gates it on the environment before it, " +
+			"so a ctrlplane_policy with for_each over progressions can define the whole chain.",
+		Attributes: map[string]schema.Attribute{
+			"environment_ids": schema.ListAttribute{
+				Required:    true,
+				Description: "The IDs of the environments in the order releases progress through them",
+				ElementType: types.StringType,
+			},
+			"progressions": schema.MapNestedAttribute{
+				Computed:    true,
+				Description: "One entry per environment after the first, keyed by the ID of the environment being gated",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"position": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The index of the gated environment in environment_ids",
+						},
+						"environment_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the environment being gated",
+						},
+						"depends_on_environment_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the environment that must succeed first",
+						},
+						"selector": schema.StringAttribute{
+							Computed:    true,
+							Description: "CEL expression matching the gated environment, for the policy selector",
+						},
+						"depends_on_environment_selector": schema.StringAttribute{
+							Computed:    true,
+							Description: "CEL expression matching the environment that must succeed first, for environment_progression.depends_on_environment_selector",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *EnvironmentProgressionMatrixDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EnvironmentProgressionMatrixDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := make(map[string]int, len(data.EnvironmentIds))
+	for i, id := range data.EnvironmentIds {
+		if id.IsNull() || id.ValueString() == "" {
+			resp.Diagnostics.AddAttributeError(path.Root("environment_ids").AtListIndex(i), "Invalid environment ID", "Environment IDs must not be empty.")
+			return
+		}
+		if first, ok := seen[id.ValueString()]; ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("environment_ids").AtListIndex(i),
+				"Duplicate environment ID",
+				fmt.Sprintf("Environment %q appears at positions %d and %d; each environment can only appear once in a progression.", id.ValueString(), first, i),
+			)
+			return
+		}
+		seen[id.ValueString()] = i
+	}
+
+	data.Progressions = make(map[string]EnvironmentProgressionMatrixEntry, len(data.EnvironmentIds))
+	for i := 1; i < len(data.EnvironmentIds); i++ {
+		id := data.EnvironmentIds[i].ValueString()
+		dependsOn := data.EnvironmentIds[i-1].ValueString()
+		data.Progressions[id] = EnvironmentProgressionMatrixEntry{
+			Position:                     types.Int64Value(int64(i)),
+			EnvironmentId:                types.StringValue(id),
+			DependsOnEnvironmentId:       types.StringValue(dependsOn),
+			Selector:                     types.StringValue(environmentIDSelector(id)),
+			DependsOnEnvironmentSelector: types.StringValue(environmentIDSelector(dependsOn)),
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func environmentIDSelector(id string) string {
+	return fmt.Sprintf("environment.id == %q", id)
+}
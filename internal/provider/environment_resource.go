@@ -8,11 +8,10 @@ import (
 	"net/http"
 
 	"github.com/ctrlplanedev/terraform-provider-ctrlplane/internal/api"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -21,6 +20,8 @@ import (
 var _ resource.Resource = &EnvironmentResource{}
 var _ resource.ResourceWithImportState = &EnvironmentResource{}
 var _ resource.ResourceWithConfigure = &EnvironmentResource{}
+var _ resource.ResourceWithModifyPlan = &EnvironmentResource{}
+var _ resource.ResourceWithValidateConfig = &EnvironmentResource{}
 
 func NewEnvironmentResource() resource.Resource {
 	return &EnvironmentResource{}
@@ -32,7 +33,7 @@ type EnvironmentResource struct {
 
 // ImportState implements resource.ResourceWithImportState.
 func (r *EnvironmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByNaturalKey(ctx, r.workspace, req, resp, resolveEnvironmentName(r.workspace))
 }
 
 // Configure implements resource.ResourceWithConfigure.
@@ -58,6 +59,13 @@ func (r *EnvironmentResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	if !data.CloneFromEnvironmentID.IsNull() {
+		resp.Diagnostics.Append(r.seedFromEnvironment(ctx, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	workspaceId := r.workspace.ID
 	var selector *string
 	if cel := normalizeCEL(data.ResourceSelector); cel != "" {
@@ -68,7 +76,7 @@ func (r *EnvironmentResource) Create(ctx context.Context, req resource.CreateReq
 		Name:             data.Name.ValueString(),
 		Description:      data.Description.ValueStringPointer(),
 		ResourceSelector: selector,
-		Metadata:         stringMapPointer(data.Metadata),
+		Metadata:         metadataRequest(r.workspace, data.Metadata),
 	}
 	envResp, err := r.workspace.Client.RequestEnvironmentCreationWithResponse(
 		ctx, workspaceId.String(), requestBody,
@@ -79,7 +87,7 @@ func (r *EnvironmentResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	if envResp.StatusCode() != http.StatusAccepted {
-		resp.Diagnostics.AddError("Failed to create environment", formatResponseError(envResp.StatusCode(), envResp.Body))
+		addResponseError(ctx, &resp.Diagnostics, "Failed to create environment", req.Plan, envResp.HTTPResponse, envResp.Body)
 		return
 	}
 
@@ -115,6 +123,9 @@ func (r *EnvironmentResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	refreshMatchedResources(ctx, r.workspace, &data, true, &resp.Diagnostics)
+
+	data.AppURL, data.EntityURL = entityURLs(r.workspace, "environments", data.ID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
 
@@ -135,7 +146,7 @@ func (r *EnvironmentResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 
 	if clientResp.StatusCode() != http.StatusAccepted && clientResp.StatusCode() != http.StatusNoContent {
-		resp.Diagnostics.AddError("Failed to delete environment", formatResponseError(clientResp.StatusCode(), clientResp.Body))
+		resp.Diagnostics.AddError("Failed to delete environment", formatResponseError(clientResp.HTTPResponse, clientResp.Body))
 		return
 	}
 }
@@ -179,7 +190,7 @@ func (r *EnvironmentResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	if envResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError("Failed to read environment", formatResponseError(envResp.StatusCode(), envResp.Body))
+		resp.Diagnostics.AddError("Failed to read environment", formatResponseError(envResp.HTTPResponse, envResp.Body))
 		return
 	}
 
@@ -193,15 +204,18 @@ func (r *EnvironmentResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	data.ID = types.StringValue(envResp.JSON200.Id)
-	data.Name = types.StringValue(envResp.JSON200.Name)
-	data.Description = descriptionValue(envResp.JSON200.Description)
-	data.Metadata = stringMapValue(envResp.JSON200.Metadata)
+	data.Name = NewTrimmedStringValue(envResp.JSON200.Name)
+	data.Description = NewTrimmedStringPointerValue(envResp.JSON200.Description)
+	data.Metadata = metadataFromAPI(r.workspace, envResp.JSON200.Metadata, data.Metadata)
 	if envResp.JSON200.ResourceSelector != nil && *envResp.JSON200.ResourceSelector != "" {
 		data.ResourceSelector = types.StringValue(*envResp.JSON200.ResourceSelector)
 	} else {
 		data.ResourceSelector = types.StringNull()
 	}
 
+	refreshMatchedResources(ctx, r.workspace, &data, false, &resp.Diagnostics)
+
+	data.AppURL, data.EntityURL = entityURLs(r.workspace, "environments", data.ID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -216,35 +230,221 @@ func (r *EnvironmentResource) Schema(ctx context.Context, req resource.SchemaReq
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"app_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "Link to the workspace in the Ctrlplane UI",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"entity_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "Link to this environment in the Ctrlplane UI",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"name": schema.StringAttribute{
+				CustomType:  TrimmedStringType{},
 				Required:    true,
 				Description: "The name of the environment",
 			},
 			"description": schema.StringAttribute{
+				CustomType:  TrimmedStringType{},
 				Optional:    true,
+				Computed:    true,
 				Description: "The description of the environment",
 			},
 			"resource_selector": schema.StringAttribute{
 				Optional:    true,
+				Computed:    true,
 				Description: "CEL expression used to select resources",
+				Validators:  celValidators(),
 				PlanModifiers: []planmodifier.String{
 					celNormalized(),
 				},
 			},
-			"metadata": schema.MapAttribute{
+			"metadata": metadataAttribute("The metadata of the environment"),
+			"clone_from_environment_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of an existing environment to copy description, resource_selector, and metadata from when this environment is created. Values set in configuration take precedence. Only used at creation; the copied values are kept afterwards until overridden.",
+			},
+			"sample_matched_resources_limit": schema.Int64Attribute{
 				Optional:    true,
+				Description: fmt.Sprintf("How many resources matched by resource_selector to list in sample_matched_resources, from 1 to %d. Unset disables sampling.", maxMatchedResourcesSample),
+			},
+			"sample_matched_resources": schema.ListNestedAttribute{
 				Computed:    true,
-				Description: "The metadata of the environment",
-				ElementType: types.StringType,
-				Default: func() defaults.Map {
-					empty, _ := types.MapValueFrom(context.Background(), types.StringType, map[string]string{})
-					return mapdefault.StaticValue(empty)
-				}(),
+				Description: "Up to sample_matched_resources_limit resources currently matched by resource_selector, so a selector change can be checked from the plan. Refreshed on every read; null when sampling is disabled.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"identifier": schema.StringAttribute{
+							Computed:    true,
+							Description: "The identifier of the resource",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the resource",
+						},
+						"kind": schema.StringAttribute{
+							Computed:    true,
+							Description: "The kind of the resource",
+						},
+					},
+				},
 			},
 		},
 	}
 }
 
+// ModifyPlan fills in the attributes that clone_from_environment_id seeds. An
+// unset description, resource_selector, or metadata is unknown at creation so
+// Create can copy it from the source environment, keeps its prior value on
+// later plans, and stays null (or empty) when no source is configured.
+func (r *EnvironmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	defer recordPlannedChange(ctx, r.workspace, "ctrlplane_environment", req, resp)
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config, plan EnvironmentResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	creating := req.State.Raw.IsNull()
+	var prior EnvironmentResourceModel
+	if !creating {
+		resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	cloning := !plan.CloneFromEnvironmentID.IsNull()
+	switch {
+	case !cloning:
+		if config.Description.IsNull() {
+			plan.Description = NewTrimmedStringNull()
+		}
+		if config.ResourceSelector.IsNull() {
+			plan.ResourceSelector = types.StringNull()
+		}
+		if config.Metadata.IsNull() {
+			plan.Metadata = unsetMetadataPlan(r.workspace, prior.Metadata, creating)
+		}
+	case creating:
+		if config.Description.IsNull() {
+			plan.Description = NewTrimmedStringUnknown()
+		}
+		if config.ResourceSelector.IsNull() {
+			plan.ResourceSelector = types.StringUnknown()
+		}
+		if config.Metadata.IsNull() {
+			plan.Metadata = NewMetadataUnknown()
+		}
+	default:
+		if config.Description.IsNull() {
+			plan.Description = prior.Description
+		}
+		if config.ResourceSelector.IsNull() {
+			plan.ResourceSelector = prior.ResourceSelector
+		}
+		if config.Metadata.IsNull() {
+			plan.Metadata = prior.Metadata
+		}
+	}
+
+	plan.SampleMatchedResources = r.planMatchedResources(ctx, plan, prior, creating)
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// planMatchedResources returns the planned sample_matched_resources. The
+// sample is taken at plan time whenever the selector or limit changes, so
+// the resources a new selector would match show up in the plan. It keeps its
+// prior value otherwise, and is unknown until apply when the selector is, or
+// when the sample cannot be taken, which Create and Update then retry.
+func (r *EnvironmentResource) planMatchedResources(ctx context.Context, plan, prior EnvironmentResourceModel, creating bool) types.List {
+	if plan.SampleMatchedResourcesLimit.IsNull() {
+		return types.ListNull(matchedResourceObjectType)
+	}
+	if plan.SampleMatchedResourcesLimit.IsUnknown() || plan.ResourceSelector.IsUnknown() || r.workspace == nil {
+		return types.ListUnknown(matchedResourceObjectType)
+	}
+	if !creating && plan.SampleMatchedResourcesLimit.Equal(prior.SampleMatchedResourcesLimit) &&
+		normalizeCEL(plan.ResourceSelector) == normalizeCEL(prior.ResourceSelector) {
+		return prior.SampleMatchedResources
+	}
+
+	sample, err := sampleMatchedResources(ctx, r.workspace, plan.ResourceSelector, plan.SampleMatchedResourcesLimit)
+	if err != nil {
+		return types.ListUnknown(matchedResourceObjectType)
+	}
+	return sample
+}
+
+// ValidateConfig implements resource.ResourceWithValidateConfig.
+func (r *EnvironmentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var limit types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("sample_matched_resources_limit"), &limit)...)
+	if resp.Diagnostics.HasError() || limit.IsNull() || limit.IsUnknown() {
+		return
+	}
+
+	if limit.ValueInt64() < 1 || limit.ValueInt64() > maxMatchedResourcesSample {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("sample_matched_resources_limit"),
+			"Invalid sample_matched_resources_limit",
+			fmt.Sprintf("sample_matched_resources_limit must be between 1 and %d, got %d.", maxMatchedResourcesSample, limit.ValueInt64()),
+		)
+	}
+}
+
+// seedFromEnvironment copies unknown description, resource_selector, and
+// metadata values from the environment named by clone_from_environment_id.
+func (r *EnvironmentResource) seedFromEnvironment(ctx context.Context, data *EnvironmentResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	sourceID := data.CloneFromEnvironmentID.ValueString()
+
+	sourceResp, err := r.workspace.Client.GetEnvironmentWithResponse(ctx, r.workspace.ID.String(), sourceID)
+	if err != nil {
+		diags.AddError("Failed to create environment", fmt.Sprintf("Failed to read source environment '%s': %s", sourceID, err.Error()))
+		return diags
+	}
+	if sourceResp.StatusCode() == http.StatusNotFound {
+		diags.AddAttributeError(
+			path.Root("clone_from_environment_id"),
+			"Failed to create environment",
+			fmt.Sprintf("Source environment '%s' not found", sourceID),
+		)
+		return diags
+	}
+	if sourceResp.StatusCode() != http.StatusOK || sourceResp.JSON200 == nil {
+		diags.AddError("Failed to create environment", formatResponseError(sourceResp.HTTPResponse, sourceResp.Body))
+		return diags
+	}
+
+	source := sourceResp.JSON200
+	if data.Description.IsUnknown() {
+		data.Description = NewTrimmedStringPointerValue(source.Description)
+	}
+	if data.ResourceSelector.IsUnknown() {
+		if source.ResourceSelector != nil && *source.ResourceSelector != "" {
+			data.ResourceSelector = types.StringValue(*source.ResourceSelector)
+		} else {
+			data.ResourceSelector = types.StringNull()
+		}
+	}
+	if data.Metadata.IsUnknown() {
+		data.Metadata = metadataFromAPI(r.workspace, source.Metadata, data.Metadata)
+	}
+	return diags
+}
+
 // Update implements resource.Resource.
 func (r *EnvironmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data EnvironmentResourceModel
@@ -262,7 +462,7 @@ func (r *EnvironmentResource) Update(ctx context.Context, req resource.UpdateReq
 		ResourceSelector: selector,
 		Name:             data.Name.ValueString(),
 		Description:      data.Description.ValueStringPointer(),
-		Metadata:         stringMapPointer(data.Metadata),
+		Metadata:         metadataRequest(r.workspace, data.Metadata),
 	}
 	envResp, err := r.workspace.Client.RequestEnvironmentUpsertWithResponse(
 		ctx, r.workspace.ID.String(), data.ID.ValueString(), requestBody,
@@ -277,7 +477,7 @@ func (r *EnvironmentResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	if envResp.StatusCode() != http.StatusAccepted {
-		resp.Diagnostics.AddError("Failed to update environment", formatResponseError(envResp.StatusCode(), envResp.Body))
+		addResponseError(ctx, &resp.Diagnostics, "Failed to update environment", req.Plan, envResp.HTTPResponse, envResp.Body)
 		return
 	}
 
@@ -294,6 +494,9 @@ func (r *EnvironmentResource) Update(ctx context.Context, req resource.UpdateReq
 
 	data.ID = types.StringValue(envId)
 
+	refreshMatchedResources(ctx, r.workspace, &data, true, &resp.Diagnostics)
+
+	data.AppURL, data.EntityURL = entityURLs(r.workspace, "environments", data.ID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
 
@@ -302,9 +505,16 @@ func (r *EnvironmentResource) Metadata(ctx context.Context, req resource.Metadat
 }
 
 type EnvironmentResourceModel struct {
-	ID               types.String `tfsdk:"id"`
-	Name             types.String `tfsdk:"name"`
-	ResourceSelector types.String `tfsdk:"resource_selector"`
-	Description      types.String `tfsdk:"description"`
-	Metadata         types.Map    `tfsdk:"metadata"`
+	ID               types.String       `tfsdk:"id"`
+	Name             TrimmedStringValue `tfsdk:"name"`
+	ResourceSelector types.String       `tfsdk:"resource_selector"`
+	Description      TrimmedStringValue `tfsdk:"description"`
+	Metadata         MetadataValue      `tfsdk:"metadata"`
+	AppURL           types.String       `tfsdk:"app_url"`
+	EntityURL        types.String       `tfsdk:"entity_url"`
+
+	CloneFromEnvironmentID types.String `tfsdk:"clone_from_environment_id"`
+
+	SampleMatchedResourcesLimit types.Int64 `tfsdk:"sample_matched_resources_limit"`
+	SampleMatchedResources      types.List  `tfsdk:"sample_matched_resources"`
 }
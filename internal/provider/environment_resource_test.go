@@ -4,11 +4,13 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
@@ -63,6 +65,25 @@ func TestAccEnvironmentResource(t *testing.T) {
 					),
 				},
 			},
+			// Import by name, with and without the workspace prefix
+			{
+				ResourceName:      "ctrlplane_environment.test",
+				ImportState:       true,
+				ImportStateId:     updatedName,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "ctrlplane_environment.test",
+				ImportState:       true,
+				ImportStateId:     testAccWorkspaceImportID(updatedName),
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  "ctrlplane_environment.test",
+				ImportState:   true,
+				ImportStateId: name,
+				ExpectError:   regexp.MustCompile(`no environment with name`),
+			},
 		},
 	})
 }
@@ -82,3 +103,290 @@ resource "ctrlplane_environment" "test" {
 }
 `, testAccProviderConfig(), name, name, description, name)
 }
+
+func TestAccEnvironmentResource_metadataAndSelector(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-env-meta-%d", time.Now().UnixNano())
+	updatedName := name + "-renamed"
+	selector := fmt.Sprintf(`"resource.name == '%s'"`, name)
+	multilineSelector := fmt.Sprintf(`<<-EOT
+    resource.name == '%s'
+      && resource.kind != ''
+  EOT`, name)
+	// Update stores the configured selector as written; only the request sent
+	// to the API is normalized.
+	storedMultilineSelector := fmt.Sprintf("resource.name == '%s'\n  && resource.kind != ''\n", name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentResourceMetadataConfig(name, "first", "one", selector),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"ctrlplane_environment.test",
+						tfjsonpath.New("metadata"),
+						knownvalue.MapExact(map[string]knownvalue.Check{
+							"key": knownvalue.StringExact("one"),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"ctrlplane_environment.test",
+						tfjsonpath.New("resource_selector"),
+						knownvalue.StringExact(fmt.Sprintf("resource.name == '%s'", name)),
+					),
+				},
+			},
+			{
+				Config: testAccEnvironmentResourceMetadataConfig(name, "first", "two", selector),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"ctrlplane_environment.test",
+						tfjsonpath.New("metadata"),
+						knownvalue.MapExact(map[string]knownvalue.Check{
+							"key": knownvalue.StringExact("two"),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"ctrlplane_environment.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact(name),
+					),
+				},
+			},
+			{
+				Config: testAccEnvironmentResourceMetadataConfig(updatedName, "second", "two", selector),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"ctrlplane_environment.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact(updatedName),
+					),
+					statecheck.ExpectKnownValue(
+						"ctrlplane_environment.test",
+						tfjsonpath.New("description"),
+						knownvalue.StringExact("second"),
+					),
+					statecheck.ExpectKnownValue(
+						"ctrlplane_environment.test",
+						tfjsonpath.New("metadata"),
+						knownvalue.MapExact(map[string]knownvalue.Check{
+							"key": knownvalue.StringExact("two"),
+						}),
+					),
+				},
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("ctrlplane_environment.test", plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: testAccEnvironmentResourceMetadataConfig(updatedName, "second", "two", multilineSelector),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"ctrlplane_environment.test",
+						tfjsonpath.New("resource_selector"),
+						knownvalue.StringExact(storedMultilineSelector),
+					),
+				},
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config:   testAccEnvironmentResourceMetadataConfig(updatedName, "second", "two", multilineSelector),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccEnvironmentResourceMetadataConfig(updatedName, "second", "two", ""),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"ctrlplane_environment.test",
+						tfjsonpath.New("resource_selector"),
+						knownvalue.Null(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccEnvironmentResourceMetadataConfig(name, description, metadataValue, selector string) string {
+	selectorLine := ""
+	if selector != "" {
+		selectorLine = "resource_selector = " + selector
+	}
+
+	return fmt.Sprintf(`
+%s
+resource "ctrlplane_environment" "test" {
+  name        = %q
+  description = %q
+  metadata = {
+    key = %q
+  }
+
+  %s
+}
+`, testAccProviderConfig(), name, description, metadataValue, selectorLine)
+}
+
+func TestAccEnvironmentResource_cloneFrom(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-env-clone-%d", time.Now().UnixNano())
+	selector := fmt.Sprintf("resource.metadata['region'] == '%s'", name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentResourceCloneConfig(name, selector, ""),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"ctrlplane_environment.clone",
+						tfjsonpath.New("description"),
+						knownvalue.StringExact("source environment"),
+					),
+					statecheck.ExpectKnownValue(
+						"ctrlplane_environment.clone",
+						tfjsonpath.New("resource_selector"),
+						knownvalue.StringExact(selector),
+					),
+					statecheck.ExpectKnownValue(
+						"ctrlplane_environment.clone",
+						tfjsonpath.New("metadata"),
+						knownvalue.MapExact(map[string]knownvalue.Check{
+							"tier": knownvalue.StringExact("prod"),
+						}),
+					),
+				},
+			},
+			{
+				Config:   testAccEnvironmentResourceCloneConfig(name, selector, ""),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccEnvironmentResourceCloneConfig(name, selector, "clone environment"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"ctrlplane_environment.clone",
+						tfjsonpath.New("description"),
+						knownvalue.StringExact("clone environment"),
+					),
+					statecheck.ExpectKnownValue(
+						"ctrlplane_environment.clone",
+						tfjsonpath.New("resource_selector"),
+						knownvalue.StringExact(selector),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccEnvironmentResourceCloneConfig(name, selector, cloneDescription string) string {
+	descriptionLine := ""
+	if cloneDescription != "" {
+		descriptionLine = fmt.Sprintf("description = %q", cloneDescription)
+	}
+
+	return fmt.Sprintf(`
+%s
+resource "ctrlplane_environment" "source" {
+  name              = %q
+  description       = "source environment"
+  resource_selector = %q
+  metadata = {
+    tier = "prod"
+  }
+}
+
+resource "ctrlplane_environment" "clone" {
+  name                      = %q
+  clone_from_environment_id = ctrlplane_environment.source.id
+
+  %s
+}
+`, testAccProviderConfig(), name+"-source", selector, name+"-clone", descriptionLine)
+}
+
+func TestAccEnvironmentResource_sampleMatchedResources(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-env-sample-%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccEnvironmentResourceSampleConfig(name, name, 0),
+				ExpectError: regexp.MustCompile(`sample_matched_resources_limit must be between 1 and 100`),
+			},
+			{
+				// Resources are created before the environment samples them.
+				Config: testAccEnvironmentResourceSampleConfig(name, "", 0),
+			},
+			{
+				Config: testAccEnvironmentResourceSampleConfig(name, name, 5),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"ctrlplane_environment.test",
+						tfjsonpath.New("sample_matched_resources"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"identifier": knownvalue.StringExact(name + "-resource"),
+								"name":       knownvalue.StringExact(name + "-resource"),
+								"kind":       knownvalue.StringExact("test/resource"),
+							}),
+						}),
+					),
+				},
+			},
+			{
+				Config: testAccEnvironmentResourceSampleConfig(name, name+"-missing", 5),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"ctrlplane_environment.test",
+						tfjsonpath.New("sample_matched_resources"),
+						knownvalue.ListSizeExact(0),
+					),
+				},
+			},
+		},
+	})
+}
+
+// testAccEnvironmentResourceSampleConfig creates a resource provider with one
+// resource, and an environment selecting resources named selectName+"-resource"
+// when selectName is set.
+func testAccEnvironmentResourceSampleConfig(name, selectName string, limit int) string {
+	environment := ""
+	if selectName != "" {
+		environment = fmt.Sprintf(`
+resource "ctrlplane_environment" "test" {
+  name                           = %q
+  resource_selector              = "resource.name == '%s-resource'"
+  sample_matched_resources_limit = %d
+}
+`, name, selectName, limit)
+	}
+
+	return fmt.Sprintf(`
+%s
+resource "ctrlplane_resource_provider" "test" {
+  name = %q
+
+  resource {
+    name       = %q
+    identifier = %q
+    kind       = "test/resource"
+    version    = "v1"
+  }
+}
+%s`, testAccProviderConfig(), name, name+"-resource", name+"-resource", environment)
+}
@@ -19,6 +19,7 @@ import (
 var _ resource.Resource = &EnvironmentSystemLinkResource{}
 var _ resource.ResourceWithImportState = &EnvironmentSystemLinkResource{}
 var _ resource.ResourceWithConfigure = &EnvironmentSystemLinkResource{}
+var _ resource.ResourceWithModifyPlan = &EnvironmentSystemLinkResource{}
 
 func NewEnvironmentSystemLinkResource() resource.Resource {
 	return &EnvironmentSystemLinkResource{}
@@ -38,6 +39,10 @@ func (r *EnvironmentSystemLinkResource) Metadata(ctx context.Context, req resour
 	resp.TypeName = req.ProviderTypeName + "_environment_system_link"
 }
 
+func (r *EnvironmentSystemLinkResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	recordPlannedChange(ctx, r.workspace, "ctrlplane_environment_system_link", req, resp)
+}
+
 func (r *EnvironmentSystemLinkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	parts := strings.SplitN(req.ID, "/", 2)
 	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
@@ -116,7 +121,7 @@ func (r *EnvironmentSystemLinkResource) Create(ctx context.Context, req resource
 	}
 
 	if linkResp.StatusCode() != http.StatusAccepted {
-		resp.Diagnostics.AddError("Failed to link environment to system", formatResponseError(linkResp.StatusCode(), linkResp.Body))
+		addResponseError(ctx, &resp.Diagnostics, "Failed to link environment to system", req.Plan, linkResp.HTTPResponse, linkResp.Body)
 		return
 	}
 
@@ -150,7 +155,7 @@ func (r *EnvironmentSystemLinkResource) Read(ctx context.Context, req resource.R
 	case http.StatusNotFound:
 		resp.State.RemoveResource(ctx)
 	default:
-		resp.Diagnostics.AddError("Failed to read environment system link", formatResponseError(linkResp.StatusCode(), linkResp.Body))
+		resp.Diagnostics.AddError("Failed to read environment system link", formatResponseError(linkResp.HTTPResponse, linkResp.Body))
 	}
 }
 
@@ -186,6 +191,6 @@ func (r *EnvironmentSystemLinkResource) Delete(ctx context.Context, req resource
 	case http.StatusNotFound:
 		return
 	default:
-		resp.Diagnostics.AddError("Failed to unlink environment from system", formatResponseError(unlinkResp.StatusCode(), unlinkResp.Body))
+		resp.Diagnostics.AddError("Failed to unlink environment from system", formatResponseError(unlinkResp.HTTPResponse, unlinkResp.Body))
 	}
 }
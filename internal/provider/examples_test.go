@@ -0,0 +1,139 @@
+// Copyright IBM Corp. 2021, 2026
+
+package provider
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const examplesDir = "../../examples"
+
+// TestAccExamples runs `terraform validate` against every runnable example
+// module (any directory under examples/ with a providers.tf) using a provider
+// binary built from this tree, so schema changes cannot silently break the
+// published examples. With CTRLPLANE_EXAMPLES_APPLY=true each example is also
+// applied to the acceptance test workspace and destroyed afterwards; variables
+// beyond workspace, url and api_key can be supplied as TF_VAR_* and a single
+// example selected with -run TestAccExamples/<name>.
+func TestAccExamples(t *testing.T) {
+	if os.Getenv(resource.EnvTfAcc) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set", resource.EnvTfAcc)
+	}
+
+	terraform := os.Getenv("TF_ACC_TERRAFORM_PATH")
+	if terraform == "" {
+		path, err := exec.LookPath("terraform")
+		if err != nil {
+			t.Skip("terraform must be on PATH or set in TF_ACC_TERRAFORM_PATH to test examples")
+		}
+		terraform = path
+	}
+
+	pluginDir := t.TempDir()
+	build := exec.Command("go", "build", "-o", filepath.Join(pluginDir, "terraform-provider-ctrlplane"), "../..")
+	if out, err := build.CombinedOutput(); err != nil {
+		t.Fatalf("building provider: %s\n%s", err, out)
+	}
+
+	cliConfig := filepath.Join(t.TempDir(), "terraform.rc")
+	if err := os.WriteFile(cliConfig, []byte(fmt.Sprintf(`provider_installation {
+  dev_overrides {
+    "ctrlplanedev/ctrlplane" = %q
+  }
+  direct {}
+}
+`, pluginDir)), 0o600); err != nil {
+		t.Fatalf("writing CLI config: %s", err)
+	}
+
+	apply := os.Getenv("CTRLPLANE_EXAMPLES_APPLY") == "true"
+
+	for _, example := range exampleModules(t) {
+		t.Run(example, func(t *testing.T) {
+			workDir := copyExample(t, filepath.Join(examplesDir, example))
+			run := func(args ...string) {
+				t.Helper()
+				cmd := exec.Command(terraform, args...)
+				cmd.Dir = workDir
+				cmd.Env = append(os.Environ(),
+					"TF_CLI_CONFIG_FILE="+cliConfig,
+					"TF_IN_AUTOMATION=1",
+					"TF_VAR_workspace="+os.Getenv("CTRLPLANE_WORKSPACE"),
+					"TF_VAR_url="+os.Getenv("CTRLPLANE_URL"),
+					"TF_VAR_api_key="+os.Getenv("CTRLPLANE_API_KEY"),
+				)
+				if out, err := cmd.CombinedOutput(); err != nil {
+					t.Fatalf("terraform %s: %s\n%s", strings.Join(args, " "), err, out)
+				}
+			}
+
+			run("validate", "-no-color")
+
+			if !apply {
+				return
+			}
+			testAccPreCheck(t)
+			t.Cleanup(func() { run("destroy", "-auto-approve", "-no-color") })
+			run("apply", "-auto-approve", "-no-color")
+		})
+	}
+}
+
+// exampleModules returns the directories under examples/ that hold a
+// providers.tf, relative to examples/.
+func exampleModules(t *testing.T) []string {
+	t.Helper()
+
+	var modules []string
+	err := filepath.WalkDir(examplesDir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() || entry.Name() != "providers.tf" {
+			return nil
+		}
+		rel, err := filepath.Rel(examplesDir, filepath.Dir(path))
+		if err != nil {
+			return err
+		}
+		modules = append(modules, filepath.ToSlash(rel))
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("listing examples: %s", err)
+	}
+	if len(modules) == 0 {
+		t.Fatalf("no example modules found under %s", examplesDir)
+	}
+	return modules
+}
+
+// copyExample copies the .tf files of an example into a temporary directory so
+// terraform state and lock files never land in the source tree.
+func copyExample(t *testing.T, dir string) string {
+	t.Helper()
+
+	workDir := t.TempDir()
+	files, err := filepath.Glob(filepath.Join(dir, "*.tf"))
+	if err != nil {
+		t.Fatalf("listing %s: %s", dir, err)
+	}
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("reading %s: %s", file, err)
+		}
+		if err := os.WriteFile(filepath.Join(workDir, filepath.Base(file)), content, 0o600); err != nil {
+			t.Fatalf("copying %s: %s", file, err)
+		}
+	}
+	return workDir
+}
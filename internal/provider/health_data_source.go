@@ -0,0 +1,100 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/ctrlplanedev/terraform-provider-ctrlplane/internal/api"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &HealthDataSource{}
+var _ datasource.DataSourceWithConfigure = &HealthDataSource{}
+
+func NewHealthDataSource() datasource.DataSource {
+	return &HealthDataSource{}
+}
+
+type HealthDataSource struct {
+	workspace *api.WorkspaceClient
+}
+
+type HealthDataSourceModel struct {
+	URL         types.String `tfsdk:"url"`
+	WorkspaceID types.String `tfsdk:"workspace_id"`
+	Healthy     types.Bool   `tfsdk:"healthy"`
+	StatusCode  types.Int64  `tfsdk:"status_code"`
+	Message     types.String `tfsdk:"message"`
+}
+
+func (d *HealthDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_health"
+}
+
+func (d *HealthDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Check that the Ctrlplane API is reachable and that the API key can access the configured workspace. " +
+			"The check never fails the plan on its own; use healthy in a precondition or check block to gate on it.",
+		Attributes: map[string]schema.Attribute{
+			"url": schema.StringAttribute{
+				Computed:    true,
+				Description: "The Ctrlplane endpoint that was checked",
+			},
+			"workspace_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the configured workspace",
+			},
+			"healthy": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the API responded and the workspace could be read",
+			},
+			"status_code": schema.Int64Attribute{
+				Computed:    true,
+				Description: "HTTP status code of the workspace request, or 0 when the API could not be reached",
+			},
+			"message": schema.StringAttribute{
+				Computed:    true,
+				Description: "Why the check failed, or null when healthy",
+			},
+		},
+	}
+}
+
+func (d *HealthDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	workspace, ok := req.ProviderData.(*api.WorkspaceClient)
+	if !ok {
+		resp.Diagnostics.AddError("Invalid provider data", "The provider data is not a *api.WorkspaceClient")
+		return
+	}
+
+	d.workspace = workspace
+}
+
+func (d *HealthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data HealthDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	status, err := d.workspace.CheckAccess(ctx)
+
+	data.URL = types.StringValue(d.workspace.Url)
+	data.WorkspaceID = types.StringValue(d.workspace.ID.String())
+	data.Healthy = types.BoolValue(err == nil)
+	data.StatusCode = types.Int64Value(int64(status))
+	data.Message = types.StringNull()
+	if err != nil {
+		data.Message = types.StringValue(err.Error())
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
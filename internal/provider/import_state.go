@@ -0,0 +1,175 @@
+// Copyright IBM Corp. 2021, 2026
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/ctrlplanedev/terraform-provider-ctrlplane/internal/api"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// importByNaturalKey imports a resource by its UUID or by a natural key such
+// as a slug or name, optionally prefixed with the workspace slug:
+//
+//	terraform import ctrlplane_system.app 7b1c...
+//	terraform import ctrlplane_system.app my-system
+//	terraform import ctrlplane_system.app my-workspace/my-system
+//
+// A prefix that does not name the configured workspace is left on the key, so
+// resolvers may accept keys of their own with a slash in them.
+//
+// resolve looks the key up in the configured workspace and returns the ID,
+// or an error when there is no single match.
+func importByNaturalKey(
+	ctx context.Context,
+	workspace *api.WorkspaceClient,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+	resolve func(ctx context.Context, key string) (string, error),
+) {
+	if _, err := uuid.Parse(req.ID); err == nil {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	key := req.ID
+	prefix, rest, hasPrefix := strings.Cut(req.ID, "/")
+	if hasPrefix && (prefix == workspace.Slug || prefix == workspace.ID.String()) {
+		key, hasPrefix = rest, false
+	}
+	if key == "" {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected an ID, a slug or name, or <workspace>/<slug or name>.")
+		return
+	}
+
+	id, err := resolve(ctx, key)
+	if err != nil {
+		detail := err.Error()
+		if hasPrefix {
+			detail += fmt.Sprintf(". If %q is a workspace, note that the provider is configured for workspace %q.", prefix, workspace.Slug)
+		}
+		resp.Diagnostics.AddError("Failed to import", detail)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func resolveSystemSlug(workspace *api.WorkspaceClient) func(context.Context, string) (string, error) {
+	return func(ctx context.Context, slug string) (string, error) {
+		id, err := findSystemIDBySlug(ctx, workspace, slug)
+		if err != nil {
+			return "", err
+		}
+		if id == "" {
+			return "", fmt.Errorf("no system with slug '%s' in workspace '%s'", slug, workspace.ID.String())
+		}
+		return id, nil
+	}
+}
+
+// resolveDeploymentSlug resolves a deployment slug, or a
+// <system-slug>/<deployment-slug> pair when the slug alone is ambiguous.
+func resolveDeploymentSlug(workspace *api.WorkspaceClient) func(context.Context, string) (string, error) {
+	return func(ctx context.Context, key string) (string, error) {
+		systemSlug, slug, scoped := strings.Cut(key, "/")
+		if !scoped {
+			slug = key
+		}
+
+		var systemID string
+		if scoped {
+			id, err := findSystemIDBySlug(ctx, workspace, systemSlug)
+			if err != nil {
+				return "", err
+			}
+			if id == "" {
+				return "", fmt.Errorf("no system with slug '%s' in workspace '%s'", systemSlug, workspace.ID.String())
+			}
+			systemID = id
+		}
+
+		deployments, err := listDeployments(ctx, workspace, nil)
+		if err != nil {
+			return "", err
+		}
+
+		var ids []string
+		for _, item := range deployments {
+			if item.Deployment.Slug != slug {
+				continue
+			}
+			if scoped && !slices.ContainsFunc(item.Systems, func(system api.System) bool { return system.Id == systemID }) {
+				continue
+			}
+			ids = append(ids, item.Deployment.Id)
+		}
+
+		switch {
+		case len(ids) == 1:
+			return ids[0], nil
+		case len(ids) > 1:
+			return "", fmt.Errorf("%d deployments with slug '%s' in workspace '%s'; import by <system-slug>/<deployment-slug> or id instead", len(ids), slug, workspace.ID.String())
+		case scoped:
+			return "", fmt.Errorf("no deployment with slug '%s' in system '%s'", slug, systemSlug)
+		default:
+			return "", fmt.Errorf("no deployment with slug '%s' in workspace '%s'", slug, workspace.ID.String())
+		}
+	}
+}
+
+func resolveEnvironmentName(workspace *api.WorkspaceClient) func(context.Context, string) (string, error) {
+	return func(ctx context.Context, name string) (string, error) {
+		envResp, err := workspace.Client.GetEnvironmentByNameWithResponse(ctx, workspace.ID.String(), name)
+		if err != nil {
+			return "", err
+		}
+		switch {
+		case envResp.StatusCode() == http.StatusNotFound:
+			return "", fmt.Errorf("no environment with name '%s' in workspace '%s'", name, workspace.ID.String())
+		case envResp.StatusCode() != http.StatusOK || envResp.JSON200 == nil:
+			return "", fmt.Errorf("%s", formatResponseError(envResp.HTTPResponse, envResp.Body))
+		}
+		return envResp.JSON200.Id, nil
+	}
+}
+
+func resolvePolicyName(workspace *api.WorkspaceClient) func(context.Context, string) (string, error) {
+	return func(ctx context.Context, name string) (string, error) {
+		policy, err := findPolicyByName(ctx, workspace, name)
+		if err != nil {
+			return "", err
+		}
+		return policy.Id, nil
+	}
+}
+
+func resolveJobAgentName(workspace *api.WorkspaceClient) func(context.Context, string) (string, error) {
+	return func(ctx context.Context, name string) (string, error) {
+		var ids []string
+		for agent, err := range api.Paginate(ctx, jobAgentPages(workspace)) {
+			if err != nil {
+				return "", err
+			}
+			if agent.Name == name {
+				ids = append(ids, agent.Id)
+			}
+		}
+
+		switch len(ids) {
+		case 0:
+			return "", fmt.Errorf("no job agent with name '%s' in workspace '%s'", name, workspace.ID.String())
+		case 1:
+			return ids[0], nil
+		default:
+			return "", fmt.Errorf("%d job agents named '%s' in workspace '%s'; import by id instead", len(ids), name, workspace.ID.String())
+		}
+	}
+}
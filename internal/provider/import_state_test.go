@@ -0,0 +1,107 @@
+// Copyright IBM Corp. 2021, 2026
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ctrlplanedev/terraform-provider-ctrlplane/internal/api"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestImportDeploymentByNaturalKey(t *testing.T) {
+	workspaceID := uuid.New()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/workspaces/" + workspaceID.String() + "/systems":
+			fmt.Fprint(w, `{"items":[
+				{"id":"sys-web","name":"Web","slug":"web","workspaceId":"ws"},
+				{"id":"sys-batch","name":"Batch","slug":"batch","workspaceId":"ws"}
+			],"total":2}`)
+		case "/v1/workspaces/" + workspaceID.String() + "/deployments":
+			fmt.Fprint(w, `{"items":[
+				{"deployment":{"id":"dep-web","name":"API","slug":"api","jobAgentSelector":"","jobAgentConfig":{}},"systems":[{"id":"sys-web","name":"Web","slug":"web","workspaceId":"ws"}]},
+				{"deployment":{"id":"dep-batch","name":"API","slug":"api","jobAgentSelector":"","jobAgentConfig":{}},"systems":[{"id":"sys-batch","name":"Batch","slug":"batch","workspaceId":"ws"}]},
+				{"deployment":{"id":"dep-worker","name":"Worker","slug":"worker","jobAgentSelector":"","jobAgentConfig":{}},"systems":[]}
+			],"total":3}`)
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := api.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	workspace := &api.WorkspaceClient{ID: workspaceID, Slug: "acme", Client: client}
+
+	cases := map[string]struct {
+		importID string
+		wantID   string
+		wantErr  string
+	}{
+		"unique slug":                  {importID: "worker", wantID: "dep-worker"},
+		"ambiguous slug":               {importID: "api", wantErr: "2 deployments with slug 'api'"},
+		"system and deployment":        {importID: "web/api", wantID: "dep-web"},
+		"workspace slug prefix":        {importID: "acme/batch/api", wantID: "dep-batch"},
+		"workspace id prefix":          {importID: workspaceID.String() + "/web/api", wantID: "dep-web"},
+		"workspace prefix on slug":     {importID: "acme/worker", wantID: "dep-worker"},
+		"unknown system":               {importID: "cron/api", wantErr: "no system with slug 'cron'"},
+		"other workspace":              {importID: "other/web/api", wantErr: `If "other" is a workspace`},
+		"deployment not in the system": {importID: "web/worker", wantErr: "no deployment with slug 'worker' in system 'web'"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			id, diags := testImportByNaturalKey(t, workspace, tc.importID, resolveDeploymentSlug(workspace))
+			if tc.wantErr != "" {
+				if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), tc.wantErr) {
+					t.Fatalf("got %v, want an error containing %q", diags, tc.wantErr)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if id != tc.wantID {
+				t.Errorf("got id %q, want %q", id, tc.wantID)
+			}
+		})
+	}
+}
+
+func testImportByNaturalKey(t *testing.T, workspace *api.WorkspaceClient, importID string, resolve func(context.Context, string) (string, error)) (string, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+	importSchema := schema.Schema{Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{Computed: true},
+	}}
+
+	resp := &resource.ImportStateResponse{State: tfsdk.State{
+		Schema: importSchema,
+		Raw:    tftypes.NewValue(importSchema.Type().TerraformType(ctx), nil),
+	}}
+	importByNaturalKey(ctx, workspace, resource.ImportStateRequest{ID: importID}, resp, resolve)
+	if resp.Diagnostics.HasError() {
+		return "", resp.Diagnostics
+	}
+
+	var id types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	return id.ValueString(), resp.Diagnostics
+}
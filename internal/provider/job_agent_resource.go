@@ -4,19 +4,24 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"slices"
+	"sort"
+	"strings"
 
 	"github.com/ctrlplanedev/terraform-provider-ctrlplane/internal/api"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -25,6 +30,7 @@ var (
 	_ resource.Resource                   = &JobAgentResource{}
 	_ resource.ResourceWithImportState    = &JobAgentResource{}
 	_ resource.ResourceWithConfigure      = &JobAgentResource{}
+	_ resource.ResourceWithModifyPlan     = &JobAgentResource{}
 	_ resource.ResourceWithValidateConfig = &JobAgentResource{}
 )
 
@@ -40,8 +46,13 @@ func (r *JobAgentResource) Metadata(ctx context.Context, req resource.MetadataRe
 	resp.TypeName = req.ProviderTypeName + "_job_agent"
 }
 
+func (r *JobAgentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(planUnsetMetadata(ctx, r.workspace, req, &resp.Plan)...)
+	recordPlannedChange(ctx, r.workspace, "ctrlplane_job_agent", req, resp)
+}
+
 func (r *JobAgentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByNaturalKey(ctx, r.workspace, req, resp, resolveJobAgentName(r.workspace))
 }
 
 func (r *JobAgentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -72,18 +83,10 @@ func (r *JobAgentResource) Schema(ctx context.Context, req resource.SchemaReques
 				Required:    true,
 				Description: "The name of the job agent",
 			},
-			"metadata": schema.MapAttribute{
-				Optional:    true,
+			"metadata": metadataAttribute("The metadata of the job agent"),
+			"config_hash": schema.StringAttribute{
 				Computed:    true,
-				Description: "The metadata of the job agent",
-				ElementType: types.StringType,
-				Default: func() defaults.Map {
-					empty, _ := types.MapValueFrom(context.Background(), types.StringType, map[string]string{})
-					return mapdefault.StaticValue(empty)
-				}(),
-				PlanModifiers: []planmodifier.Map{
-					mapplanmodifier.UseStateForUnknown(),
-				},
+				Description: "SHA-256 of the job agent configuration as stored by the server, including keys the configuration blocks do not model. A change between refreshes means the agent was reconfigured outside Terraform.",
 			},
 		},
 		Blocks: map[string]schema.Block{
@@ -179,6 +182,30 @@ func (r *JobAgentResource) Schema(ctx context.Context, req resource.SchemaReques
 					},
 				},
 			},
+			"azure_devops": schema.ListNestedBlock{
+				Description: "Azure DevOps Pipelines job agent configuration",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"organization_url": schema.StringAttribute{
+							Required:    true,
+							Description: "Azure DevOps organization URL (e.g. https://dev.azure.com/my-org)",
+						},
+						"project": schema.StringAttribute{
+							Required:    true,
+							Description: "Azure DevOps project name",
+						},
+						"pipeline_id": schema.Int64Attribute{
+							Required:    true,
+							Description: "Azure Pipelines pipeline definition ID",
+						},
+						"personal_access_token": schema.StringAttribute{
+							Required:    true,
+							Description: "Azure DevOps personal access token with permission to queue pipeline runs",
+							Sensitive:   true,
+						},
+					},
+				},
+			},
 			"terraform_cloud": schema.ListNestedBlock{
 				Description: "Terraform Cloud job agent configuration",
 				NestedObject: schema.NestedBlockObject{
@@ -211,6 +238,56 @@ func (r *JobAgentResource) Schema(ctx context.Context, req resource.SchemaReques
 					},
 				},
 			},
+			"kubernetes": schema.ListNestedBlock{
+				Description: "Kubernetes job agent configuration",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"kubeconfig_secret_ref": schema.StringAttribute{
+							Optional:    true,
+							Description: "Name of the Kubernetes secret holding the kubeconfig for the target cluster. The agent uses its in-cluster credentials when unset",
+						},
+						"namespace": schema.StringAttribute{
+							Optional:    true,
+							Description: "Namespace to create jobs in",
+						},
+						"template": schema.StringAttribute{
+							Required:    true,
+							Description: "Kubernetes Job manifest template",
+						},
+					},
+				},
+			},
+			"webhook": schema.ListNestedBlock{
+				Description: "Webhook job agent configuration, which sends each job to an HTTP endpoint such as an AWS Lambda function URL",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"url": schema.StringAttribute{
+							Required:    true,
+							Description: "URL the job is sent to",
+						},
+						"method": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "HTTP method of the request: one of GET, POST, PUT, PATCH, or DELETE (defaults to POST)",
+							Default:     stringdefault.StaticString("POST"),
+						},
+						"headers": schema.MapAttribute{
+							Optional:    true,
+							Description: "Headers added to the request",
+							ElementType: types.StringType,
+						},
+						"signing_secret": schema.StringAttribute{
+							Optional:    true,
+							Description: "Secret used to sign the request body with HMAC-SHA256",
+							Sensitive:   true,
+						},
+						"payload_template": schema.StringAttribute{
+							Optional:    true,
+							Description: "Template for the request body. The job is sent as JSON when unset",
+						},
+					},
+				},
+			},
 			"test_runner": schema.ListNestedBlock{
 				Description: "Test runner job agent configuration",
 				NestedObject: schema.NestedBlockObject{
@@ -245,16 +322,27 @@ func (r *JobAgentResource) ValidateConfig(ctx context.Context, req resource.Vali
 	if count == 0 {
 		resp.Diagnostics.AddError(
 			"Invalid job agent configuration",
-			"Exactly one of custom, argocd, github, terraform_cloud, or test_runner must be set.",
+			"Exactly one of custom, argocd, argo_workflow, github, azure_devops, terraform_cloud, kubernetes, webhook, or test_runner must be set.",
 		)
 		return
 	}
 	if count > 1 {
 		resp.Diagnostics.AddError(
 			"Invalid job agent configuration",
-			"Only one of custom, argocd, github, terraform_cloud, or test_runner can be set.",
+			"Only one of custom, argocd, argo_workflow, github, azure_devops, terraform_cloud, kubernetes, webhook, or test_runner can be set.",
 		)
 	}
+
+	if len(data.Webhook) > 0 {
+		method := data.Webhook[0].Method
+		if !method.IsNull() && !method.IsUnknown() && !slices.Contains(webhookMethods, method.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("webhook").AtListIndex(0).AtName("method"),
+				"Invalid webhook method",
+				fmt.Sprintf("method must be one of %s, got %q.", strings.Join(webhookMethods, ", "), method.ValueString()),
+			)
+		}
+	}
 }
 
 func (r *JobAgentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -282,7 +370,7 @@ func (r *JobAgentResource) Create(ctx context.Context, req resource.CreateReques
 
 	requestBody := api.RequestJobAgentUpsertJSONRequestBody{
 		Config:   *config,
-		Metadata: stringMapPointer(data.Metadata),
+		Metadata: metadataRequest(r.workspace, data.Metadata),
 		Name:     data.Name.ValueString(),
 		Type:     jobAgentType,
 	}
@@ -296,7 +384,7 @@ func (r *JobAgentResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	if jobAgentResp.StatusCode() != http.StatusAccepted {
-		resp.Diagnostics.AddError("Failed to create job agent", formatResponseError(jobAgentResp.StatusCode(), jobAgentResp.Body))
+		addResponseError(ctx, &resp.Diagnostics, "Failed to create job agent", req.Plan, jobAgentResp.HTTPResponse, jobAgentResp.Body)
 		return
 	}
 
@@ -307,6 +395,7 @@ func (r *JobAgentResource) Create(ctx context.Context, req resource.CreateReques
 
 	agentId := jobAgentResp.JSON202.Id
 	data.ID = types.StringValue(agentId)
+	data.ConfigHash = types.StringNull()
 
 	err = waitForResource(ctx, func() (bool, error) {
 		getResp, err := r.workspace.Client.GetJobAgentWithResponse(ctx, r.workspace.ID.String(), agentId)
@@ -315,6 +404,9 @@ func (r *JobAgentResource) Create(ctx context.Context, req resource.CreateReques
 		}
 		switch getResp.StatusCode() {
 		case http.StatusOK:
+			if getResp.JSON200 != nil {
+				data.ConfigHash = jobAgentConfigHash(getResp.JSON200.Config)
+			}
 			return true, nil
 		case http.StatusNotFound:
 			return false, nil
@@ -361,19 +453,14 @@ func (r *JobAgentResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	if jobAgentResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError("Failed to read job agent", formatResponseError(jobAgentResp.StatusCode(), jobAgentResp.Body))
+		resp.Diagnostics.AddError("Failed to read job agent", formatResponseError(jobAgentResp.HTTPResponse, jobAgentResp.Body))
 		return
 	}
 
 	jobAgent := jobAgentResp.JSON200
 	data.ID = types.StringValue(jobAgent.Id)
 	data.Name = types.StringValue(jobAgent.Name)
-	if jobAgent.Metadata == nil {
-		empty, _ := types.MapValueFrom(context.Background(), types.StringType, map[string]string{})
-		data.Metadata = empty
-	} else {
-		data.Metadata = stringMapValue(&jobAgent.Metadata)
-	}
+	data.Metadata = metadataFromAPI(r.workspace, &jobAgent.Metadata, data.Metadata)
 
 	// Preserve sensitive fields that the API doesn't return.
 	var priorToken types.String
@@ -387,7 +474,21 @@ func (r *JobAgentResource) Read(ctx context.Context, req resource.ReadRequest, r
 		priorArgoWorkflowWebhookSecret = data.ArgoWorkflow[0].WebhookSecret
 	}
 
+	var priorWebhookSecret types.String
+	if len(data.Webhook) > 0 {
+		priorWebhookSecret = data.Webhook[0].SigningSecret
+	}
+
+	var priorAzureDevOpsToken types.String
+	if len(data.AzureDevOps) > 0 {
+		priorAzureDevOpsToken = data.AzureDevOps[0].PersonalAccessToken
+	}
+
 	setJobAgentBlocksFromAPI(&data, jobAgent.Type, jobAgent.Config)
+	data.ConfigHash = jobAgentConfigHash(jobAgent.Config)
+	if r.workspace.Features.UnmanagedConfigWarnings {
+		warnUnmodeledJobAgentConfig(jobAgent.Type, jobAgent.Config, &resp.Diagnostics)
+	}
 
 	// Restore token from prior state since the API never returns it.
 	if len(data.TerraformCloud) > 0 && !priorToken.IsNull() {
@@ -400,6 +501,16 @@ func (r *JobAgentResource) Read(ctx context.Context, req resource.ReadRequest, r
 		data.ArgoWorkflow[0].WebhookSecret = priorArgoWorkflowWebhookSecret
 	}
 
+	// Restore the Azure DevOps personal access token from prior state since the API never returns it.
+	if len(data.AzureDevOps) > 0 && data.AzureDevOps[0].PersonalAccessToken.IsNull() {
+		data.AzureDevOps[0].PersonalAccessToken = priorAzureDevOpsToken
+	}
+
+	// Restore the webhook signing secret from prior state when the API does not return it.
+	if len(data.Webhook) > 0 && data.Webhook[0].SigningSecret.IsNull() {
+		data.Webhook[0].SigningSecret = priorWebhookSecret
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -422,7 +533,7 @@ func (r *JobAgentResource) Update(ctx context.Context, req resource.UpdateReques
 
 	requestBody := api.RequestJobAgentUpsertJSONRequestBody{
 		Config:   *config,
-		Metadata: stringMapPointer(data.Metadata),
+		Metadata: metadataRequest(r.workspace, data.Metadata),
 		Name:     data.Name.ValueString(),
 		Type:     jobAgentType,
 	}
@@ -439,7 +550,7 @@ func (r *JobAgentResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	if jobAgentResp.StatusCode() != http.StatusAccepted {
-		resp.Diagnostics.AddError("Failed to update job agent", formatResponseError(jobAgentResp.StatusCode(), jobAgentResp.Body))
+		addResponseError(ctx, &resp.Diagnostics, "Failed to update job agent", req.Plan, jobAgentResp.HTTPResponse, jobAgentResp.Body)
 		return
 	}
 
@@ -449,6 +560,23 @@ func (r *JobAgentResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	data.ID = types.StringValue(jobAgentResp.JSON202.Id)
+	data.ConfigHash = types.StringNull()
+	getResp, err := r.workspace.Client.GetJobAgentWithResponse(ctx, r.workspace.ID.String(), data.ID.ValueString())
+	switch {
+	case err != nil:
+		resp.Diagnostics.AddWarning(
+			"Failed to read job agent config hash",
+			fmt.Sprintf("The job agent was updated, but reading it back failed, so config_hash is unset until the next refresh: %s", err.Error()),
+		)
+	case getResp.StatusCode() != http.StatusOK || getResp.JSON200 == nil:
+		resp.Diagnostics.AddWarning(
+			"Failed to read job agent config hash",
+			fmt.Sprintf("The job agent was updated, but reading it back failed, so config_hash is unset until the next refresh: %s", formatResponseError(getResp.HTTPResponse, getResp.Body)),
+		)
+	default:
+		data.ConfigHash = jobAgentConfigHash(getResp.JSON200.Config)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
 
@@ -480,18 +608,22 @@ func (r *JobAgentResource) Delete(ctx context.Context, req resource.DeleteReques
 		}
 	}
 
-	resp.Diagnostics.AddError("Failed to delete job agent", formatResponseError(jobAgentResp.StatusCode(), jobAgentResp.Body))
+	resp.Diagnostics.AddError("Failed to delete job agent", formatResponseError(jobAgentResp.HTTPResponse, jobAgentResp.Body))
 }
 
 type JobAgentResourceModel struct {
 	ID             types.String                `tfsdk:"id"`
 	Name           types.String                `tfsdk:"name"`
-	Metadata       types.Map                   `tfsdk:"metadata"`
+	Metadata       MetadataValue               `tfsdk:"metadata"`
+	ConfigHash     types.String                `tfsdk:"config_hash"`
 	Custom         []JobAgentCustomModel       `tfsdk:"custom"`
 	ArgoCD         []JobAgentArgoCDModel       `tfsdk:"argocd"`
 	ArgoWorkflow   []JobAgentArgoWorkflowModel `tfsdk:"argo_workflow"`
 	GitHub         []JobAgentGitHubModel       `tfsdk:"github"`
+	AzureDevOps    []JobAgentAzureDevOpsModel  `tfsdk:"azure_devops"`
 	TerraformCloud []JobAgentTFCModel          `tfsdk:"terraform_cloud"`
+	Kubernetes     []JobAgentKubernetesModel   `tfsdk:"kubernetes"`
+	Webhook        []JobAgentWebhookModel      `tfsdk:"webhook"`
 	TestRunner     []JobAgentTestRunnerModel   `tfsdk:"test_runner"`
 }
 
@@ -520,6 +652,13 @@ type JobAgentGitHubModel struct {
 	Repo           types.String `tfsdk:"repo"`
 }
 
+type JobAgentAzureDevOpsModel struct {
+	OrganizationUrl     types.String `tfsdk:"organization_url"`
+	Project             types.String `tfsdk:"project"`
+	PipelineId          types.Int64  `tfsdk:"pipeline_id"`
+	PersonalAccessToken types.String `tfsdk:"personal_access_token"`
+}
+
 type JobAgentTFCModel struct {
 	Address            types.String `tfsdk:"address"`
 	Organization       types.String `tfsdk:"organization"`
@@ -529,6 +668,23 @@ type JobAgentTFCModel struct {
 	TriggerRunOnChange types.Bool   `tfsdk:"trigger_run_on_change"`
 }
 
+type JobAgentKubernetesModel struct {
+	KubeconfigSecretRef types.String `tfsdk:"kubeconfig_secret_ref"`
+	Namespace           types.String `tfsdk:"namespace"`
+	Template            types.String `tfsdk:"template"`
+}
+
+type JobAgentWebhookModel struct {
+	Url             types.String `tfsdk:"url"`
+	Method          types.String `tfsdk:"method"`
+	Headers         types.Map    `tfsdk:"headers"`
+	SigningSecret   types.String `tfsdk:"signing_secret"`
+	PayloadTemplate types.String `tfsdk:"payload_template"`
+}
+
+// webhookMethods are the HTTP methods a webhook job agent can send.
+var webhookMethods = []string{"GET", "POST", "PUT", "PATCH", "DELETE"}
+
 type JobAgentTestRunnerModel struct {
 	DelaySeconds types.Int64  `tfsdk:"delay_seconds"`
 	Message      types.String `tfsdk:"message"`
@@ -549,9 +705,18 @@ func countJobAgentConfigs(data JobAgentResourceModel) int {
 	if len(data.GitHub) > 0 {
 		count++
 	}
+	if len(data.AzureDevOps) > 0 {
+		count++
+	}
 	if len(data.TerraformCloud) > 0 {
 		count++
 	}
+	if len(data.Kubernetes) > 0 {
+		count++
+	}
+	if len(data.Webhook) > 0 {
+		count++
+	}
 	if len(data.TestRunner) > 0 {
 		count++
 	}
@@ -599,6 +764,15 @@ func jobAgentConfigFromModel(data JobAgentResourceModel) (string, *map[string]in
 			"repo":           github.Repo.ValueString(),
 		}
 		return "github-app", &cfg, nil
+	case len(data.AzureDevOps) > 0:
+		azureDevOps := data.AzureDevOps[0]
+		cfg := map[string]interface{}{
+			"organizationUrl":     azureDevOps.OrganizationUrl.ValueString(),
+			"project":             azureDevOps.Project.ValueString(),
+			"pipelineId":          azureDevOps.PipelineId.ValueInt64(),
+			"personalAccessToken": azureDevOps.PersonalAccessToken.ValueString(),
+		}
+		return "azure-devops", &cfg, nil
 	case len(data.TerraformCloud) > 0:
 		tfc := data.TerraformCloud[0]
 		cfg := map[string]interface{}{
@@ -614,6 +788,26 @@ func jobAgentConfigFromModel(data JobAgentResourceModel) (string, *map[string]in
 			cfg["triggerRunOnChange"] = tfc.TriggerRunOnChange.ValueBool()
 		}
 		return "tfe", &cfg, nil
+	case len(data.Kubernetes) > 0:
+		kubernetes := data.Kubernetes[0]
+		cfg := map[string]interface{}{
+			"template": kubernetes.Template.ValueString(),
+		}
+		setStringIfSet(cfg, "kubeconfigSecretRef", kubernetes.KubeconfigSecretRef)
+		setStringIfSet(cfg, "namespace", kubernetes.Namespace)
+		return "kubernetes-job", &cfg, nil
+	case len(data.Webhook) > 0:
+		webhook := data.Webhook[0]
+		cfg := map[string]interface{}{
+			"url":    webhook.Url.ValueString(),
+			"method": webhook.Method.ValueString(),
+		}
+		if headers := stringInterfaceMapPointer(webhook.Headers); headers != nil {
+			cfg["headers"] = *headers
+		}
+		setStringIfSet(cfg, "signingSecret", webhook.SigningSecret)
+		setStringIfSet(cfg, "payloadTemplate", webhook.PayloadTemplate)
+		return "webhook", &cfg, nil
 	case len(data.TestRunner) > 0:
 		testRunner := data.TestRunner[0]
 		cfg := map[string]interface{}{}
@@ -636,7 +830,10 @@ func setJobAgentBlocksFromAPI(data *JobAgentResourceModel, jobType string, confi
 	data.ArgoCD = nil
 	data.ArgoWorkflow = nil
 	data.GitHub = nil
+	data.AzureDevOps = nil
 	data.TerraformCloud = nil
+	data.Kubernetes = nil
+	data.Webhook = nil
 	data.TestRunner = nil
 	data.Custom = nil
 
@@ -671,6 +868,14 @@ func setJobAgentBlocksFromAPI(data *JobAgentResourceModel, jobType string, confi
 			Repo:           types.StringValue(fmt.Sprint(config["repo"])),
 		}
 		data.GitHub = []JobAgentGitHubModel{github}
+	case "azure-devops":
+		azureDevOps := JobAgentAzureDevOpsModel{
+			OrganizationUrl:     stringValueOrNull(config["organizationUrl"]),
+			Project:             stringValueOrNull(config["project"]),
+			PipelineId:          types.Int64Value(toInt64(config["pipelineId"])),
+			PersonalAccessToken: types.StringNull(),
+		}
+		data.AzureDevOps = []JobAgentAzureDevOpsModel{azureDevOps}
 	case "tfe":
 		tfc := JobAgentTFCModel{
 			Address:            stringValueOrNull(config["address"]),
@@ -681,6 +886,29 @@ func setJobAgentBlocksFromAPI(data *JobAgentResourceModel, jobType string, confi
 			TriggerRunOnChange: boolValueOrNull(config["triggerRunOnChange"]),
 		}
 		data.TerraformCloud = []JobAgentTFCModel{tfc}
+	case "kubernetes-job":
+		data.Kubernetes = []JobAgentKubernetesModel{
+			{
+				KubeconfigSecretRef: stringValueOrNull(config["kubeconfigSecretRef"]),
+				Namespace:           stringValueOrNull(config["namespace"]),
+				Template:            stringValueOrNull(config["template"]),
+			},
+		}
+	case "webhook":
+		webhook := JobAgentWebhookModel{
+			Url:             stringValueOrNull(config["url"]),
+			Method:          types.StringValue("POST"),
+			Headers:         types.MapNull(types.StringType),
+			SigningSecret:   stringValueOrNull(config["signingSecret"]),
+			PayloadTemplate: stringValueOrNull(config["payloadTemplate"]),
+		}
+		if method, ok := config["method"]; ok && method != nil {
+			webhook.Method = types.StringValue(fmt.Sprint(method))
+		}
+		if headers, ok := config["headers"].(map[string]interface{}); ok {
+			webhook.Headers = interfaceMapStringValue(headers)
+		}
+		data.Webhook = []JobAgentWebhookModel{webhook}
 	case "test-runner":
 		testRunner := JobAgentTestRunnerModel{
 			DelaySeconds: types.Int64Null(),
@@ -707,6 +935,76 @@ func setJobAgentBlocksFromAPI(data *JobAgentResourceModel, jobType string, confi
 	}
 }
 
+// jobAgentTypeBlocks maps job agent types to the typed block that configures
+// them, which has the same name on ctrlplane_job_agent and
+// ctrlplane_deployment.
+var jobAgentTypeBlocks = map[string]string{
+	"argo-cd":        "argocd",
+	"argo-workflow":  "argo_workflow",
+	"github-app":     "github",
+	"azure-devops":   "azure_devops",
+	"tfe":            "terraform_cloud",
+	"kubernetes-job": "kubernetes",
+	"webhook":        "webhook",
+	"test-runner":    "test_runner",
+}
+
+// jobAgentModeledKeys lists the config keys each typed block reads and writes.
+// Custom agents keep the whole config map, so they have no entry.
+var jobAgentModeledKeys = map[string][]string{
+	"argo-cd":        {"apiKey", "serverUrl", "template"},
+	"argo-workflow":  {"apiKey", "webhookSecret", "serverUrl", "template", "name", "httpInsecure"},
+	"github-app":     {"installationId", "owner", "repo"},
+	"azure-devops":   {"organizationUrl", "project", "pipelineId", "personalAccessToken"},
+	"tfe":            {"address", "organization", "template", "token", "webhookUrl", "triggerRunOnChange"},
+	"kubernetes-job": {"kubeconfigSecretRef", "namespace", "template"},
+	"webhook":        {"url", "method", "headers", "signingSecret", "payloadTemplate"},
+	"test-runner":    {"delaySeconds", "message", "status"},
+}
+
+// jobAgentConfigHash returns the hex SHA-256 of config encoded as JSON, whose
+// map keys are sorted, so equal configs always hash the same.
+func jobAgentConfigHash(config map[string]interface{}) types.String {
+	encoded, err := json.Marshal(config)
+	if err != nil {
+		return types.StringNull()
+	}
+	sum := sha256.Sum256(encoded)
+	return types.StringValue(hex.EncodeToString(sum[:]))
+}
+
+// warnUnmodeledJobAgentConfig warns about server-side config keys that the
+// agent's typed block cannot represent. They were most likely added in the UI
+// and will be dropped by the next apply, which replaces the whole config.
+func warnUnmodeledJobAgentConfig(jobType string, config map[string]interface{}, diags *diag.Diagnostics) {
+	modeled, ok := jobAgentModeledKeys[jobType]
+	if !ok {
+		return
+	}
+
+	var unmodeled []string
+	for key := range config {
+		if !slices.Contains(modeled, key) {
+			unmodeled = append(unmodeled, key)
+		}
+	}
+	if len(unmodeled) == 0 {
+		return
+	}
+	sort.Strings(unmodeled)
+
+	diags.AddWarning(
+		"Job agent has unmanaged configuration",
+		fmt.Sprintf(
+			"The %s job agent config on the server has keys this provider does not manage: %s. "+
+				"They were probably set outside Terraform. They do not show up in the plan, "+
+				"but the next apply that updates this job agent will remove them. "+
+				"Use a custom block to manage the full config map instead.",
+			jobType, strings.Join(unmodeled, ", "),
+		),
+	)
+}
+
 func toInt64(value interface{}) int64 {
 	switch v := value.(type) {
 	case int:
@@ -723,3 +1021,19 @@ func toInt64(value interface{}) int64 {
 		return 0
 	}
 }
+
+func jobAgentPages(workspace *api.WorkspaceClient) api.PageFunc[api.JobAgent] {
+	return func(ctx context.Context, limit, offset int) (api.Page[api.JobAgent], error) {
+		listResp, err := workspace.Client.ListJobAgentsWithResponse(ctx, workspace.ID.String(), &api.ListJobAgentsParams{
+			Limit:  &limit,
+			Offset: &offset,
+		})
+		if err != nil {
+			return api.Page[api.JobAgent]{}, fmt.Errorf("failed to list job agents: %w", err)
+		}
+		if listResp.StatusCode() != http.StatusOK || listResp.JSON200 == nil {
+			return api.Page[api.JobAgent]{}, fmt.Errorf("%s", formatResponseError(listResp.HTTPResponse, listResp.Body))
+		}
+		return api.Page[api.JobAgent]{Items: listResp.JSON200.Items, Total: listResp.JSON200.Total}, nil
+	}
+}
@@ -4,6 +4,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
@@ -29,6 +30,11 @@ func TestAccJobAgentResource(t *testing.T) {
 						tfjsonpath.New("id"),
 						knownvalue.NotNull(),
 					),
+					statecheck.ExpectKnownValue(
+						"ctrlplane_job_agent.test",
+						tfjsonpath.New("config_hash"),
+						knownvalue.StringRegexp(regexp.MustCompile(`^[0-9a-f]{64}$`)),
+					),
 					statecheck.ExpectKnownValue(
 						"ctrlplane_job_agent.test",
 						tfjsonpath.New("name"),
@@ -51,6 +57,52 @@ func TestAccJobAgentResource(t *testing.T) {
 					),
 				},
 			},
+			// Import by name, with and without the workspace prefix
+			{
+				ResourceName:      "ctrlplane_job_agent.test",
+				ImportState:       true,
+				ImportStateId:     updatedName,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "ctrlplane_job_agent.test",
+				ImportState:       true,
+				ImportStateId:     testAccWorkspaceImportID(updatedName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJobAgentResource_importAmbiguousName(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-ja-dup-%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+resource "ctrlplane_job_agent" "first" {
+  name = %q
+
+  test_runner {}
+}
+
+resource "ctrlplane_job_agent" "second" {
+  name = %q
+
+  test_runner {}
+}
+`, testAccProviderConfig(), name, name),
+			},
+			{
+				ResourceName:  "ctrlplane_job_agent.first",
+				ImportState:   true,
+				ImportStateId: name,
+				ExpectError:   regexp.MustCompile(`2 job agents named`),
+			},
 		},
 	})
 }
@@ -68,3 +120,133 @@ resource "ctrlplane_job_agent" "test" {
 }
 `, testAccProviderConfig(), name, delaySeconds, status)
 }
+
+func TestAccJobAgentResource_kubernetes(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-ja-k8s-%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobAgentResourceKubernetesConfig(name, "jobs"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"ctrlplane_job_agent.test",
+						tfjsonpath.New("kubernetes").AtSliceIndex(0).AtMapKey("namespace"),
+						knownvalue.StringExact("jobs"),
+					),
+					statecheck.ExpectKnownValue(
+						"ctrlplane_job_agent.test",
+						tfjsonpath.New("kubernetes").AtSliceIndex(0).AtMapKey("kubeconfig_secret_ref"),
+						knownvalue.Null(),
+					),
+				},
+			},
+			{
+				ResourceName:      "ctrlplane_job_agent.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccJobAgentResourceKubernetesConfig(name, "jobs-updated"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"ctrlplane_job_agent.test",
+						tfjsonpath.New("kubernetes").AtSliceIndex(0).AtMapKey("namespace"),
+						knownvalue.StringExact("jobs-updated"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccJobAgentResourceKubernetesConfig(name string, namespace string) string {
+	return fmt.Sprintf(`
+%s
+resource "ctrlplane_job_agent" "test" {
+  name = %q
+
+  kubernetes {
+    namespace = %q
+    template  = <<-EOT
+      apiVersion: batch/v1
+      kind: Job
+      metadata:
+        name: "{{ .job.id }}"
+      spec:
+        template:
+          spec:
+            restartPolicy: Never
+            containers:
+              - name: deploy
+                image: busybox
+                command: ["echo", "{{ .release.version.tag }}"]
+    EOT
+  }
+}
+`, testAccProviderConfig(), name, namespace)
+}
+
+func TestAccJobAgentResource_webhook(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-ja-webhook-%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobAgentResourceWebhookConfig(name, "https://example.com/hooks/deploy"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"ctrlplane_job_agent.test",
+						tfjsonpath.New("webhook").AtSliceIndex(0).AtMapKey("method"),
+						knownvalue.StringExact("POST"),
+					),
+					statecheck.ExpectKnownValue(
+						"ctrlplane_job_agent.test",
+						tfjsonpath.New("webhook").AtSliceIndex(0).AtMapKey("headers"),
+						knownvalue.MapExact(map[string]knownvalue.Check{
+							"X-Source": knownvalue.StringExact("ctrlplane"),
+						}),
+					),
+				},
+			},
+			{
+				ResourceName:            "ctrlplane_job_agent.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"webhook.0.signing_secret"},
+			},
+			{
+				Config: testAccJobAgentResourceWebhookConfig(name, "https://example.com/hooks/deploy-v2"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"ctrlplane_job_agent.test",
+						tfjsonpath.New("webhook").AtSliceIndex(0).AtMapKey("url"),
+						knownvalue.StringExact("https://example.com/hooks/deploy-v2"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccJobAgentResourceWebhookConfig(name string, url string) string {
+	return fmt.Sprintf(`
+%s
+resource "ctrlplane_job_agent" "test" {
+  name = %q
+
+  webhook {
+    url              = %q
+    signing_secret   = "tf-acc-secret"
+    payload_template = jsonencode({ version = "{{ .release.version.tag }}" })
+    headers = {
+      X-Source = "ctrlplane"
+    }
+  }
+}
+`, testAccProviderConfig(), name, url)
+}
@@ -0,0 +1,149 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/ctrlplanedev/terraform-provider-ctrlplane/internal/api"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &JobAgentTypesDataSource{}
+var _ datasource.DataSourceWithConfigure = &JobAgentTypesDataSource{}
+
+func NewJobAgentTypesDataSource() datasource.DataSource {
+	return &JobAgentTypesDataSource{}
+}
+
+// JobAgentTypesDataSource lists the job agent types the provider has typed
+// blocks for, along with any other types used by agents in the workspace. The
+// API has no endpoint describing agent types or their config, so config keys
+// come from the provider's blocks, or are collected from existing agents for
+// types it does not model.
+type JobAgentTypesDataSource struct {
+	workspace *api.WorkspaceClient
+}
+
+type JobAgentTypesDataSourceModel struct {
+	Types []JobAgentTypesDataSourceType `tfsdk:"types"`
+}
+
+type JobAgentTypesDataSourceType struct {
+	Type       types.String `tfsdk:"type"`
+	Block      types.String `tfsdk:"block"`
+	ConfigKeys []string     `tfsdk:"config_keys"`
+	AgentCount types.Int64  `tfsdk:"agent_count"`
+}
+
+func (d *JobAgentTypesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_job_agent_types"
+}
+
+func (d *JobAgentTypesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List job agent types: those the provider has typed blocks for, plus any other type used by a job agent in the configured workspace. " +
+			"The API does not describe agent types, so config keys of types without a typed block are collected from the agents that use them.",
+		Attributes: map[string]schema.Attribute{
+			"types": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The job agent types, sorted by type",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The job agent type, e.g. github-app",
+						},
+						"block": schema.StringAttribute{
+							Computed:    true,
+							Description: "The typed block that configures this type on ctrlplane_job_agent and ctrlplane_deployment, or null when the type must use the custom block",
+						},
+						"config_keys": schema.ListAttribute{
+							Computed:    true,
+							Description: "The config keys of the type: those its typed block manages, or otherwise every key seen on agents of the type, sorted",
+							ElementType: types.StringType,
+						},
+						"agent_count": schema.Int64Attribute{
+							Computed:    true,
+							Description: "How many job agents in the workspace have this type",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *JobAgentTypesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	workspace, ok := req.ProviderData.(*api.WorkspaceClient)
+	if !ok {
+		resp.Diagnostics.AddError("Invalid provider data", "The provider data is not a *api.WorkspaceClient")
+		return
+	}
+
+	d.workspace = workspace
+}
+
+func (d *JobAgentTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data JobAgentTypesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	agents, err := api.Collect(api.Paginate(ctx, jobAgentPages(d.workspace)))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list job agents", err.Error())
+		return
+	}
+
+	counts := map[string]int64{}
+	observedKeys := map[string]map[string]bool{}
+	for _, agent := range agents {
+		counts[agent.Type]++
+		if observedKeys[agent.Type] == nil {
+			observedKeys[agent.Type] = map[string]bool{}
+		}
+		for key := range agent.Config {
+			observedKeys[agent.Type][key] = true
+		}
+	}
+
+	all := map[string]bool{}
+	for jobType := range jobAgentModeledKeys {
+		all[jobType] = true
+	}
+	for jobType := range counts {
+		all[jobType] = true
+	}
+
+	data.Types = make([]JobAgentTypesDataSourceType, 0, len(all))
+	for _, jobType := range slices.Sorted(maps.Keys(all)) {
+		entry := JobAgentTypesDataSourceType{
+			Type:       types.StringValue(jobType),
+			Block:      types.StringNull(),
+			AgentCount: types.Int64Value(counts[jobType]),
+		}
+		if block, ok := jobAgentTypeBlocks[jobType]; ok {
+			entry.Block = types.StringValue(block)
+		}
+		if modeled, ok := jobAgentModeledKeys[jobType]; ok {
+			entry.ConfigKeys = slices.Sorted(slices.Values(modeled))
+		} else {
+			entry.ConfigKeys = slices.AppendSeq([]string{}, maps.Keys(observedKeys[jobType]))
+			slices.Sort(entry.ConfigKeys)
+		}
+		data.Types = append(data.Types, entry)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,229 @@
+// Copyright IBM Corp. 2021, 2026
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ctrlplanedev/terraform-provider-ctrlplane/internal/api"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.MapTypable = MetadataType{}
+var _ basetypes.MapValuable = MetadataValue{}
+
+// MetadataType is the string map used for the metadata attribute of every
+// managed entity. The API does not distinguish between an entity without
+// metadata and one with an empty metadata object, and omits the field in
+// some responses, so both mean "no metadata". By default both are stored as
+// an empty map, which is also what an unset attribute plans to, so they never
+// show up as a diff. With the metadata_null_is_empty provider feature turned
+// off, the value is stored exactly as the API returns it, and an unset
+// attribute plans to keep it; see unsetMetadataPlan.
+type MetadataType struct {
+	basetypes.MapType
+}
+
+func NewMetadataType() MetadataType {
+	return MetadataType{MapType: basetypes.MapType{ElemType: types.StringType}}
+}
+
+func (t MetadataType) String() string {
+	return "MetadataType"
+}
+
+func (t MetadataType) Equal(o attr.Type) bool {
+	other, ok := o.(MetadataType)
+	if !ok {
+		return false
+	}
+	return t.MapType.Equal(other.MapType)
+}
+
+func (t MetadataType) ValueType(ctx context.Context) attr.Value {
+	return MetadataValue{MapValue: basetypes.NewMapNull(types.StringType)}
+}
+
+func (t MetadataType) ValueFromMap(ctx context.Context, in basetypes.MapValue) (basetypes.MapValuable, diag.Diagnostics) {
+	return MetadataValue{MapValue: in}, nil
+}
+
+func (t MetadataType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.MapType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	mapValue, ok := attrValue.(basetypes.MapValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return MetadataValue{MapValue: mapValue}, nil
+}
+
+// MetadataValue is the value type of MetadataType.
+type MetadataValue struct {
+	basetypes.MapValue
+}
+
+func NewMetadataNull() MetadataValue {
+	return MetadataValue{MapValue: basetypes.NewMapNull(types.StringType)}
+}
+
+func NewMetadataUnknown() MetadataValue {
+	return MetadataValue{MapValue: basetypes.NewMapUnknown(types.StringType)}
+}
+
+// NewMetadataValue converts metadata returned by the API. A missing or empty
+// map becomes an empty map when nullIsEmpty is set. Otherwise a missing map
+// becomes null and an empty one stays empty.
+func NewMetadataValue(metadata *map[string]string, nullIsEmpty bool) MetadataValue {
+	if metadata == nil || *metadata == nil {
+		if !nullIsEmpty {
+			return NewMetadataNull()
+		}
+		metadata = &map[string]string{}
+	}
+	return MetadataValue{MapValue: stringMapValue(metadata)}
+}
+
+func (v MetadataValue) Type(ctx context.Context) attr.Type {
+	return NewMetadataType()
+}
+
+func (v MetadataValue) Equal(o attr.Value) bool {
+	other, ok := o.(MetadataValue)
+	if !ok {
+		return false
+	}
+	return v.MapValue.Equal(other.MapValue)
+}
+
+// Request returns the metadata to send to the API. A null value is sent as an
+// empty map when nullIsEmpty is set, so the server's metadata is cleared, and
+// is left out otherwise. An unknown value is only applied when metadata is
+// unset and planned to be cleared, so it is sent as an empty map.
+func (v MetadataValue) Request(nullIsEmpty bool) *map[string]string {
+	if v.IsUnknown() || (v.IsNull() && nullIsEmpty) {
+		return &map[string]string{}
+	}
+	return stringMapPointer(v.MapValue)
+}
+
+// metadataRequest returns the metadata to send for v: the provider's
+// default_metadata with v's entries on top, so a key set on the entity wins.
+func metadataRequest(workspace *api.WorkspaceClient, v MetadataValue) *map[string]string {
+	metadata := v.Request(workspace.Features.MetadataNullIsEmpty)
+	if len(workspace.DefaultMetadata) == 0 {
+		return metadata
+	}
+
+	merged := make(map[string]string, len(workspace.DefaultMetadata))
+	for key, value := range workspace.DefaultMetadata {
+		merged[key] = value
+	}
+	if metadata != nil {
+		for key, value := range *metadata {
+			merged[key] = value
+		}
+	}
+	return &merged
+}
+
+// metadataFromAPI converts metadata returned by the API, leaving out the
+// entries that default_metadata added. An entry is left out when its key and
+// value match a default and prior does not have the key, so a key configured
+// with its default value stays in state.
+func metadataFromAPI(workspace *api.WorkspaceClient, metadata *map[string]string, prior MetadataValue) MetadataValue {
+	if len(workspace.DefaultMetadata) == 0 || metadata == nil || *metadata == nil {
+		return NewMetadataValue(metadata, workspace.Features.MetadataNullIsEmpty)
+	}
+
+	priorElements := map[string]attr.Value{}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		priorElements = prior.Elements()
+	}
+
+	own := make(map[string]string, len(*metadata))
+	for key, value := range *metadata {
+		if defaultValue, ok := workspace.DefaultMetadata[key]; ok && defaultValue == value {
+			if _, configured := priorElements[key]; !configured {
+				continue
+			}
+		}
+		own[key] = value
+	}
+	return NewMetadataValue(&own, workspace.Features.MetadataNullIsEmpty)
+}
+
+// metadataAttribute returns the schema of an entity's metadata attribute.
+// Leaving it unset manages the entity as having no metadata. The planned
+// value of an unset attribute depends on the provider's features, so it is
+// set by the resource's ModifyPlan through planUnsetMetadata rather than a
+// schema default.
+func metadataAttribute(description string) schema.MapAttribute {
+	return schema.MapAttribute{
+		CustomType:  NewMetadataType(),
+		Optional:    true,
+		Computed:    true,
+		Description: description,
+		ElementType: types.StringType,
+		PlanModifiers: []planmodifier.Map{
+			mapplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// unsetMetadataPlan returns the planned metadata of an entity whose
+// configuration leaves metadata unset. With metadata_null_is_empty that is an
+// empty map, which is what Read stores for an entity without metadata.
+// Otherwise Read stores null or an empty map as the API returns it, so either
+// is kept as planned, metadata with entries plans to be cleared, and a new
+// entity's metadata is known after apply.
+func unsetMetadataPlan(workspace *api.WorkspaceClient, prior MetadataValue, creating bool) MetadataValue {
+	if workspace == nil || workspace.Features.MetadataNullIsEmpty {
+		return NewMetadataValue(nil, true)
+	}
+	if creating || prior.IsUnknown() || (!prior.IsNull() && len(prior.Elements()) > 0) {
+		return NewMetadataUnknown()
+	}
+	return prior
+}
+
+// planUnsetMetadata sets the planned metadata through unsetMetadataPlan when
+// the configuration leaves it unset.
+func planUnsetMetadata(ctx context.Context, workspace *api.WorkspaceClient, req resource.ModifyPlanRequest, plan *tfsdk.Plan) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if req.Plan.Raw.IsNull() {
+		return diags
+	}
+
+	var config MetadataValue
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("metadata"), &config)...)
+	if diags.HasError() || !config.IsNull() {
+		return diags
+	}
+
+	creating := req.State.Raw.IsNull()
+	prior := NewMetadataNull()
+	if !creating {
+		diags.Append(req.State.GetAttribute(ctx, path.Root("metadata"), &prior)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+	diags.Append(plan.SetAttribute(ctx, path.Root("metadata"), unsetMetadataPlan(workspace, prior, creating))...)
+	return diags
+}
@@ -0,0 +1,211 @@
+// Copyright IBM Corp. 2021, 2026
+
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/ctrlplanedev/terraform-provider-ctrlplane/internal/api"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func metadataTestValue(m map[string]string) MetadataValue {
+	return NewMetadataValue(&m, true)
+}
+
+func TestMetadataType(t *testing.T) {
+	ctx := context.Background()
+	metadataType := NewMetadataType()
+
+	if !metadataType.Equal(NewMetadataType()) {
+		t.Errorf("MetadataType is not equal to itself")
+	}
+	if metadataType.Equal(basetypes.MapType{ElemType: basetypes.StringType{}}) {
+		t.Errorf("MetadataType is equal to a plain map type")
+	}
+	if got := metadataType.ValueType(ctx); !got.Equal(NewMetadataNull()) {
+		t.Errorf("ValueType = %v, want a null MetadataValue", got)
+	}
+
+	in := tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+		"team": tftypes.NewValue(tftypes.String, "platform"),
+	})
+	got, err := metadataType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		t.Fatalf("ValueFromTerraform: %v", err)
+	}
+	want := metadataTestValue(map[string]string{"team": "platform"})
+	if !got.Equal(want) {
+		t.Errorf("ValueFromTerraform = %v, want %v", got, want)
+	}
+	if !got.Type(ctx).Equal(metadataType) {
+		t.Errorf("value type = %v, want MetadataType", got.Type(ctx))
+	}
+
+	null, err := metadataType.ValueFromTerraform(ctx, tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil))
+	if err != nil {
+		t.Fatalf("ValueFromTerraform: %v", err)
+	}
+	if !null.Equal(NewMetadataNull()) {
+		t.Errorf("ValueFromTerraform of null = %v, want null", null)
+	}
+}
+
+func TestNewMetadataValue(t *testing.T) {
+	empty := map[string]string{}
+	var nilMap map[string]string
+
+	cases := map[string]struct {
+		metadata    *map[string]string
+		nullIsEmpty bool
+		want        MetadataValue
+	}{
+		"missing":                 {metadata: nil, nullIsEmpty: true, want: metadataTestValue(map[string]string{})},
+		"nil map":                 {metadata: &nilMap, nullIsEmpty: true, want: metadataTestValue(map[string]string{})},
+		"empty":                   {metadata: &empty, nullIsEmpty: true, want: metadataTestValue(map[string]string{})},
+		"missing without feature": {metadata: nil, want: NewMetadataNull()},
+		"nil map without feature": {metadata: &nilMap, want: NewMetadataNull()},
+		"empty without feature":   {metadata: &empty, want: metadataTestValue(map[string]string{})},
+		"entries":                 {metadata: &map[string]string{"a": "1"}, nullIsEmpty: true, want: metadataTestValue(map[string]string{"a": "1"})},
+		"entries without feature": {metadata: &map[string]string{"a": "1"}, want: metadataTestValue(map[string]string{"a": "1"})},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := NewMetadataValue(tc.metadata, tc.nullIsEmpty); !got.Equal(tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMetadataRequest(t *testing.T) {
+	cases := map[string]struct {
+		value       MetadataValue
+		nullIsEmpty bool
+		defaults    map[string]string
+		want        map[string]string
+		wantNil     bool
+	}{
+		"null":                   {value: NewMetadataNull(), nullIsEmpty: true, want: map[string]string{}},
+		"null without feature":   {value: NewMetadataNull(), wantNil: true},
+		"unknown":                {value: NewMetadataUnknown(), want: map[string]string{}},
+		"entries":                {value: metadataTestValue(map[string]string{"a": "1"}), nullIsEmpty: true, want: map[string]string{"a": "1"}},
+		"defaults under entries": {value: metadataTestValue(map[string]string{"a": "1"}), nullIsEmpty: true, defaults: map[string]string{"a": "0", "b": "2"}, want: map[string]string{"a": "1", "b": "2"}},
+		"defaults with null":     {value: NewMetadataNull(), defaults: map[string]string{"b": "2"}, want: map[string]string{"b": "2"}},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			workspace := &api.WorkspaceClient{
+				Features:        api.Features{MetadataNullIsEmpty: tc.nullIsEmpty},
+				DefaultMetadata: tc.defaults,
+			}
+			got := metadataRequest(workspace, tc.value)
+			if tc.wantNil {
+				if got != nil {
+					t.Errorf("got %v, want nil", *got)
+				}
+				return
+			}
+			if got == nil {
+				t.Fatalf("got nil, want %v", tc.want)
+			}
+			if !reflect.DeepEqual(*got, tc.want) {
+				t.Errorf("got %v, want %v", *got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMetadataFromAPI(t *testing.T) {
+	defaults := map[string]string{"team": "platform", "env": "prod"}
+
+	cases := map[string]struct {
+		metadata    *map[string]string
+		prior       MetadataValue
+		defaults    map[string]string
+		nullIsEmpty bool
+		want        MetadataValue
+	}{
+		"missing": {
+			nullIsEmpty: true,
+			defaults:    defaults,
+			want:        metadataTestValue(map[string]string{}),
+		},
+		"missing without feature": {
+			defaults: defaults,
+			want:     NewMetadataNull(),
+		},
+		"defaults removed": {
+			metadata:    &map[string]string{"team": "platform", "env": "prod", "owner": "alice"},
+			prior:       NewMetadataNull(),
+			defaults:    defaults,
+			nullIsEmpty: true,
+			want:        metadataTestValue(map[string]string{"owner": "alice"}),
+		},
+		"configured default kept": {
+			metadata:    &map[string]string{"team": "platform", "env": "prod"},
+			prior:       metadataTestValue(map[string]string{"team": "platform"}),
+			defaults:    defaults,
+			nullIsEmpty: true,
+			want:        metadataTestValue(map[string]string{"team": "platform"}),
+		},
+		"overridden default kept": {
+			metadata:    &map[string]string{"team": "web", "env": "prod"},
+			prior:       NewMetadataUnknown(),
+			defaults:    defaults,
+			nullIsEmpty: true,
+			want:        metadataTestValue(map[string]string{"team": "web"}),
+		},
+		"no defaults": {
+			metadata:    &map[string]string{"team": "platform"},
+			prior:       NewMetadataNull(),
+			nullIsEmpty: true,
+			want:        metadataTestValue(map[string]string{"team": "platform"}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			workspace := &api.WorkspaceClient{
+				Features:        api.Features{MetadataNullIsEmpty: tc.nullIsEmpty},
+				DefaultMetadata: tc.defaults,
+			}
+			if got := metadataFromAPI(workspace, tc.metadata, tc.prior); !got.Equal(tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestUnsetMetadataPlan(t *testing.T) {
+	empty := metadataTestValue(map[string]string{})
+	entries := metadataTestValue(map[string]string{"a": "1"})
+
+	cases := map[string]struct {
+		workspace *api.WorkspaceClient
+		prior     MetadataValue
+		creating  bool
+		want      MetadataValue
+	}{
+		"unconfigured provider":         {prior: NewMetadataNull(), creating: true, want: empty},
+		"create":                        {workspace: &api.WorkspaceClient{Features: api.Features{MetadataNullIsEmpty: true}}, prior: NewMetadataNull(), creating: true, want: empty},
+		"clear entries":                 {workspace: &api.WorkspaceClient{Features: api.Features{MetadataNullIsEmpty: true}}, prior: entries, want: empty},
+		"create without feature":        {workspace: &api.WorkspaceClient{}, prior: NewMetadataNull(), creating: true, want: NewMetadataUnknown()},
+		"keep null without feature":     {workspace: &api.WorkspaceClient{}, prior: NewMetadataNull(), want: NewMetadataNull()},
+		"keep empty without feature":    {workspace: &api.WorkspaceClient{}, prior: empty, want: empty},
+		"clear entries without feature": {workspace: &api.WorkspaceClient{}, prior: entries, want: NewMetadataUnknown()},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := unsetMetadataPlan(tc.workspace, tc.prior, tc.creating); !got.Equal(tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
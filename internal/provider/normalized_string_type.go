@@ -0,0 +1,202 @@
+// Copyright IBM Corp. 2021, 2026
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = TrimmedStringType{}
+var _ basetypes.StringValuableWithSemanticEquals = TrimmedStringValue{}
+var _ basetypes.StringTypable = SlugType{}
+var _ basetypes.StringValuableWithSemanticEquals = SlugValue{}
+
+// TrimmedStringType is a string attribute the API stores with surrounding
+// whitespace trimmed, such as a name or description. Values that differ only
+// in that whitespace are treated as equal and the configured spelling is kept
+// in state.
+type TrimmedStringType struct {
+	basetypes.StringType
+}
+
+func (t TrimmedStringType) String() string {
+	return "TrimmedStringType"
+}
+
+func (t TrimmedStringType) Equal(o attr.Type) bool {
+	other, ok := o.(TrimmedStringType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t TrimmedStringType) ValueType(ctx context.Context) attr.Value {
+	return TrimmedStringValue{}
+}
+
+func (t TrimmedStringType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return TrimmedStringValue{StringValue: in}, nil
+}
+
+func (t TrimmedStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return TrimmedStringValue{StringValue: stringValue}, nil
+}
+
+// TrimmedStringValue is the value type of TrimmedStringType.
+type TrimmedStringValue struct {
+	basetypes.StringValue
+}
+
+func NewTrimmedStringValue(value string) TrimmedStringValue {
+	return TrimmedStringValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func NewTrimmedStringNull() TrimmedStringValue {
+	return TrimmedStringValue{StringValue: basetypes.NewStringNull()}
+}
+
+func NewTrimmedStringUnknown() TrimmedStringValue {
+	return TrimmedStringValue{StringValue: basetypes.NewStringUnknown()}
+}
+
+// NewTrimmedStringPointerValue returns a null value for a nil pointer, like
+// descriptionValue does for plain strings.
+func NewTrimmedStringPointerValue(value *string) TrimmedStringValue {
+	if value == nil {
+		return NewTrimmedStringNull()
+	}
+	return NewTrimmedStringValue(*value)
+}
+
+func (v TrimmedStringValue) Type(ctx context.Context) attr.Type {
+	return TrimmedStringType{}
+}
+
+func (v TrimmedStringValue) Equal(o attr.Value) bool {
+	other, ok := o.(TrimmedStringValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals reports whether both values are equal once surrounding
+// whitespace is trimmed.
+func (v TrimmedStringValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(TrimmedStringValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got %T. Please report this to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	return strings.TrimSpace(v.ValueString()) == strings.TrimSpace(newValue.ValueString()), diags
+}
+
+// SlugType is a slug attribute. The API trims and lowercases slugs, so values
+// that normalize to the same slug are treated as equal and the configured
+// spelling is kept in state.
+type SlugType struct {
+	basetypes.StringType
+}
+
+func (t SlugType) String() string {
+	return "SlugType"
+}
+
+func (t SlugType) Equal(o attr.Type) bool {
+	other, ok := o.(SlugType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t SlugType) ValueType(ctx context.Context) attr.Value {
+	return SlugValue{}
+}
+
+func (t SlugType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return SlugValue{StringValue: in}, nil
+}
+
+func (t SlugType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return SlugValue{StringValue: stringValue}, nil
+}
+
+// SlugValue is the value type of SlugType.
+type SlugValue struct {
+	basetypes.StringValue
+}
+
+func NewSlugValue(value string) SlugValue {
+	return SlugValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func NewSlugNull() SlugValue {
+	return SlugValue{StringValue: basetypes.NewStringNull()}
+}
+
+func (v SlugValue) Type(ctx context.Context) attr.Type {
+	return SlugType{}
+}
+
+func (v SlugValue) Equal(o attr.Value) bool {
+	other, ok := o.(SlugValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals reports whether both values normalize to the same slug.
+func (v SlugValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(SlugValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got %T. Please report this to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	return normalizeSlug(v.ValueString()) == normalizeSlug(newValue.ValueString()), diags
+}
+
+func normalizeSlug(slug string) string {
+	return strings.ToLower(strings.TrimSpace(slug))
+}
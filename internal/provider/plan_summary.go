@@ -0,0 +1,254 @@
+// Copyright IBM Corp. 2021, 2026
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"sync"
+
+	"github.com/ctrlplanedev/terraform-provider-ctrlplane/internal/api"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// planSummaryVersion is the format version written to plan summary files.
+const planSummaryVersion = 1
+
+// planSummary collects the changes planned for Ctrlplane resources and
+// writes them to a JSON file for review tooling. Provider configurations
+// that share a file share its summary.
+type planSummary struct {
+	path string
+
+	mu      sync.Mutex
+	changes []plannedChange
+	// byID indexes changes to existing resources by resource type and ID, so
+	// planning a resource again replaces its entry.
+	byID map[string]int
+	// pendingReplaces counts replace entries, by resource type and
+	// configuration, whose follow-up create has not been planned yet.
+	pendingReplaces map[string]int
+}
+
+// plannedChange is one entry of a plan summary file. Values of changed
+// attributes are left out, since some of them are sensitive.
+type plannedChange struct {
+	ResourceType      string   `json:"resource_type"`
+	Operation         string   `json:"operation"`
+	Workspace         string   `json:"workspace"`
+	ID                string   `json:"id,omitempty"`
+	Name              string   `json:"name,omitempty"`
+	ChangedAttributes []string `json:"changed_attributes,omitempty"`
+	ReplaceAttributes []string `json:"replace_attributes,omitempty"`
+}
+
+var (
+	planSummariesMu sync.Mutex
+	planSummaries   = map[string]*planSummary{}
+)
+
+// openPlanSummary returns the summary written to file, creating the file
+// with no changes the first time it is opened, so a file left by an earlier
+// run is replaced even when nothing changes.
+func openPlanSummary(file string) (*planSummary, error) {
+	file, err := filepath.Abs(file)
+	if err != nil {
+		return nil, err
+	}
+
+	planSummariesMu.Lock()
+	defer planSummariesMu.Unlock()
+	if summary, ok := planSummaries[file]; ok {
+		return summary, nil
+	}
+
+	summary := &planSummary{path: file, byID: map[string]int{}, pendingReplaces: map[string]int{}}
+	if err := summary.write(); err != nil {
+		return nil, err
+	}
+	planSummaries[file] = summary
+	return summary, nil
+}
+
+// recordPlannedChange adds the change planned in resp to the workspace's plan
+// summary, if it has one. It is called at the end of each resource's
+// ModifyPlan, once the plan is final; resources with nothing else to plan
+// implement ModifyPlan only to call it. Failing to write the summary is logged
+// rather than failing the plan.
+func recordPlannedChange(ctx context.Context, workspace *api.WorkspaceClient, resourceType string, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if workspace == nil || workspace.PlanSummaryFile == "" || resp.Diagnostics.HasError() {
+		return
+	}
+
+	change, ok := plannedChangeFor(resourceType, req, resp)
+	if !ok {
+		return
+	}
+	change.Workspace = workspace.Slug
+
+	summary, err := openPlanSummary(workspace.PlanSummaryFile)
+	if err == nil {
+		err = summary.record(change, workspace.Slug+"/"+resourceType+"/"+req.Config.Raw.String())
+	}
+	if err != nil {
+		tflog.Warn(ctx, "Failed to write plan summary", map[string]interface{}{
+			"path":  workspace.PlanSummaryFile,
+			"error": err.Error(),
+		})
+	}
+}
+
+// plannedChangeFor describes the change planned in resp, reporting false
+// when nothing changes.
+func plannedChangeFor(resourceType string, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) (plannedChange, bool) {
+	change := plannedChange{ResourceType: resourceType}
+	switch {
+	case req.State.Raw.IsNull():
+		change.Operation = "create"
+	case req.Plan.Raw.IsNull():
+		change.Operation = "delete"
+	case resp.Plan.Raw.Equal(req.State.Raw):
+		return change, false
+	case len(resp.RequiresReplace) > 0:
+		change.Operation = "replace"
+	default:
+		change.Operation = "update"
+	}
+
+	planned := resp.Plan.Raw
+	if change.Operation == "delete" {
+		planned = req.State.Raw
+	}
+	change.ID = knownStringAttribute(planned, "id")
+	change.Name = knownStringAttribute(planned, "name")
+	if change.Operation == "update" || change.Operation == "replace" {
+		change.ChangedAttributes = changedAttributes(req.State.Raw, resp.Plan.Raw)
+		for _, p := range resp.RequiresReplace {
+			change.ReplaceAttributes = append(change.ReplaceAttributes, p.String())
+		}
+		sort.Strings(change.ReplaceAttributes)
+	}
+	return change, true
+}
+
+// record adds change to the summary and rewrites the file. The provider is
+// not told resource addresses, so identical resources, such as instances of
+// a count, cannot be told apart: every create is kept as its own entry, and
+// changes to existing resources are keyed by ID. When a resource is
+// replaced, Terraform plans the replacement's create next with the same
+// configuration and no prior state; configKey matches that create to the
+// replace so it is not reported twice.
+func (s *planSummary) record(change plannedChange, configKey string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case change.Operation == "create":
+		if s.pendingReplaces[configKey] > 0 {
+			s.pendingReplaces[configKey]--
+			return nil
+		}
+		s.changes = append(s.changes, change)
+	default:
+		key := change.ResourceType + "/" + change.ID
+		if i, ok := s.byID[key]; ok {
+			s.changes[i] = change
+			break
+		}
+		s.byID[key] = len(s.changes)
+		s.changes = append(s.changes, change)
+		if change.Operation == "replace" {
+			s.pendingReplaces[configKey]++
+		}
+	}
+	return s.write()
+}
+
+// write replaces the summary file through a rename, so a reader never sees a
+// partly written file. The caller must hold s.mu or own s exclusively.
+func (s *planSummary) write() error {
+	changes := slices.Clone(s.changes)
+	if changes == nil {
+		changes = []plannedChange{}
+	}
+	sort.SliceStable(changes, func(i, j int) bool {
+		a, b := changes[i], changes[j]
+		if a.ResourceType != b.ResourceType {
+			return a.ResourceType < b.ResourceType
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		if a.ID != b.ID {
+			return a.ID < b.ID
+		}
+		return a.Operation < b.Operation
+	})
+
+	content, err := json.MarshalIndent(struct {
+		Version int             `json:"version"`
+		Changes []plannedChange `json:"changes"`
+	}{planSummaryVersion, changes}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(append(content, '\n')); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path)
+}
+
+// knownStringAttribute returns the top-level string attribute name of an
+// object value, or "" when it is null, unknown, or not a string.
+func knownStringAttribute(object tftypes.Value, name string) string {
+	value, _, err := tftypes.WalkAttributePath(object, tftypes.NewAttributePath().WithAttributeName(name))
+	if err != nil {
+		return ""
+	}
+	v, ok := value.(tftypes.Value)
+	if !ok || !v.IsKnown() || v.IsNull() || !v.Type().Is(tftypes.String) {
+		return ""
+	}
+	var s string
+	if err := v.As(&s); err != nil {
+		return ""
+	}
+	return s
+}
+
+// changedAttributes returns the names of the top-level attributes whose
+// planned value differs from the prior state, in order.
+func changedAttributes(prior, planned tftypes.Value) []string {
+	var before, after map[string]tftypes.Value
+	if prior.As(&before) != nil || planned.As(&after) != nil {
+		return nil
+	}
+
+	var changed []string
+	for name, value := range after {
+		if old, ok := before[name]; !ok || !old.Equal(value) {
+			changed = append(changed, name)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
@@ -0,0 +1,168 @@
+// Copyright IBM Corp. 2021, 2026
+
+package provider
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var planSummaryTestType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+	"id":   tftypes.String,
+	"name": tftypes.String,
+}}
+
+func planSummaryTestValue(id, name interface{}) tftypes.Value {
+	return tftypes.NewValue(planSummaryTestType, map[string]tftypes.Value{
+		"id":   tftypes.NewValue(tftypes.String, id),
+		"name": tftypes.NewValue(tftypes.String, name),
+	})
+}
+
+func readPlanSummary(t *testing.T, file string) []plannedChange {
+	t.Helper()
+	content, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatalf("reading summary: %v", err)
+	}
+	var summary struct {
+		Version int             `json:"version"`
+		Changes []plannedChange `json:"changes"`
+	}
+	if err := json.Unmarshal(content, &summary); err != nil {
+		t.Fatalf("decoding summary: %v", err)
+	}
+	if summary.Version != planSummaryVersion {
+		t.Errorf("version = %d, want %d", summary.Version, planSummaryVersion)
+	}
+	if summary.Changes == nil {
+		t.Errorf("changes is null, want an array")
+	}
+	return summary.Changes
+}
+
+func TestPlannedChangeFor(t *testing.T) {
+	null := tftypes.NewValue(planSummaryTestType, nil)
+	prior := planSummaryTestValue("id-1", "old")
+	renamed := planSummaryTestValue("id-1", "new")
+
+	cases := map[string]struct {
+		state, plan     tftypes.Value
+		requiresReplace bool
+		want            plannedChange
+		wantOK          bool
+	}{
+		"create": {
+			state:  null,
+			plan:   planSummaryTestValue(tftypes.UnknownValue, "new"),
+			want:   plannedChange{ResourceType: "ctrlplane_system", Operation: "create", Name: "new"},
+			wantOK: true,
+		},
+		"delete": {
+			state:  prior,
+			plan:   null,
+			want:   plannedChange{ResourceType: "ctrlplane_system", Operation: "delete", ID: "id-1", Name: "old"},
+			wantOK: true,
+		},
+		"no change": {
+			state: prior,
+			plan:  prior,
+		},
+		"update": {
+			state:  prior,
+			plan:   renamed,
+			want:   plannedChange{ResourceType: "ctrlplane_system", Operation: "update", ID: "id-1", Name: "new", ChangedAttributes: []string{"name"}},
+			wantOK: true,
+		},
+		"replace": {
+			state:           prior,
+			plan:            renamed,
+			requiresReplace: true,
+			want: plannedChange{
+				ResourceType:      "ctrlplane_system",
+				Operation:         "replace",
+				ID:                "id-1",
+				Name:              "new",
+				ChangedAttributes: []string{"name"},
+				ReplaceAttributes: []string{"name"},
+			},
+			wantOK: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			req := resource.ModifyPlanRequest{
+				State: tfsdk.State{Raw: tc.state},
+				Plan:  tfsdk.Plan{Raw: tc.plan},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan{Raw: tc.plan}}
+			if tc.requiresReplace {
+				resp.RequiresReplace = path.Paths{path.Root("name")}
+			}
+
+			got, ok := plannedChangeFor("ctrlplane_system", req, resp)
+			if ok != tc.wantOK {
+				t.Fatalf("ok = %t, want %t", ok, tc.wantOK)
+			}
+			if ok && !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPlanSummaryRecord(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "nested", "plan.json")
+	summary, err := openPlanSummary(file)
+	if err != nil {
+		t.Fatalf("opening summary: %v", err)
+	}
+	if got := readPlanSummary(t, file); len(got) != 0 {
+		t.Fatalf("new summary has %d changes, want none", len(got))
+	}
+	if again, err := openPlanSummary(file); err != nil || again != summary {
+		t.Fatalf("reopening summary returned %p, %v; want %p", again, err, summary)
+	}
+
+	record := func(change plannedChange, configKey string) {
+		t.Helper()
+		change.Workspace = "ws"
+		if err := summary.record(change, configKey); err != nil {
+			t.Fatalf("recording %+v: %v", change, err)
+		}
+	}
+
+	// Identical count instances are each reported.
+	record(plannedChange{ResourceType: "ctrlplane_system", Operation: "create", Name: "b"}, "system-b")
+	record(plannedChange{ResourceType: "ctrlplane_system", Operation: "create", Name: "b"}, "system-b")
+	// The create planned for a replacement folds into its replace.
+	record(plannedChange{ResourceType: "ctrlplane_system", Operation: "replace", ID: "id-2", Name: "a"}, "system-a")
+	record(plannedChange{ResourceType: "ctrlplane_system", Operation: "create", Name: "a"}, "system-a")
+	// A resource planned again keeps a single entry.
+	record(plannedChange{ResourceType: "ctrlplane_environment", Operation: "update", ID: "id-1", Name: "x"}, "environment-x")
+	record(plannedChange{ResourceType: "ctrlplane_environment", Operation: "update", ID: "id-1", Name: "y"}, "environment-y")
+
+	want := []plannedChange{
+		{ResourceType: "ctrlplane_environment", Operation: "update", Workspace: "ws", ID: "id-1", Name: "y"},
+		{ResourceType: "ctrlplane_system", Operation: "replace", Workspace: "ws", ID: "id-2", Name: "a"},
+		{ResourceType: "ctrlplane_system", Operation: "create", Workspace: "ws", Name: "b"},
+		{ResourceType: "ctrlplane_system", Operation: "create", Workspace: "ws", Name: "b"},
+	}
+	if got := readPlanSummary(t, file); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v\nwant %+v", got, want)
+	}
+
+	matches, err := filepath.Glob(file + ".*.tmp")
+	if err != nil || len(matches) != 0 {
+		t.Errorf("temporary files left behind: %v %v", matches, err)
+	}
+}
@@ -0,0 +1,446 @@
+// Copyright IBM Corp. 2021, 2026
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/ctrlplanedev/terraform-provider-ctrlplane/internal/api"
+	ctrlplanevalidator "github.com/ctrlplanedev/terraform-provider-ctrlplane/internal/validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/teambition/rrule-go"
+)
+
+var _ resource.ResourceWithConfigValidators = &PolicyResource{}
+
+func (r *PolicyResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		policyVerificationValidator{},
+		policyGradualRolloutValidator{},
+		policyDeploymentWindowValidator{},
+		policyVerificationWindowValidator{},
+	}
+}
+
+// policyVerificationValidator checks the structure of verification rules:
+// at least one metric, each with a success block and exactly one provider,
+// a positive count, and a sleep duration within the API's bounds.
+type policyVerificationValidator struct{}
+
+func (policyVerificationValidator) Description(_ context.Context) string {
+	return "Verification rules must define at least one metric, and each metric needs a success block and exactly one provider block."
+}
+
+func (v policyVerificationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (policyVerificationValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	verifications, paths, diags := policyRuleElements[PolicyVerificationRule](ctx, req.Config, "verification")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, verification := range verifications {
+		rulePath := paths[i]
+		if len(verification.Metric) == 0 {
+			resp.Diagnostics.AddAttributeError(rulePath, "Invalid verification rule", "Verification rule must define at least one metric block.")
+			continue
+		}
+
+		for j, metric := range verification.Metric {
+			metricPath := rulePath.AtName("metric").AtListIndex(j)
+			if metric.Success == nil {
+				resp.Diagnostics.AddAttributeError(metricPath.AtName("success"), "Invalid verification metric", "A success block is required.")
+			}
+
+			providers := 0
+			if metric.Sleep != nil {
+				providers++
+			}
+			if metric.Datadog != nil {
+				providers++
+			}
+			if metric.Prometheus != nil {
+				providers++
+			}
+			if metric.HTTP != nil {
+				providers++
+			}
+			if providers != 1 {
+				resp.Diagnostics.AddAttributeError(metricPath, "Invalid verification metric", "Exactly one of sleep, datadog, prometheus or http provider block is required.")
+			}
+
+			if metric.HTTP != nil && !metric.HTTP.Method.IsUnknown() && !metric.HTTP.Method.IsNull() {
+				switch api.HTTPMetricProviderMethod(metric.HTTP.Method.ValueString()) {
+				case api.GET, api.POST, api.PUT, api.PATCH, api.DELETE, api.HEAD, api.OPTIONS:
+				default:
+					resp.Diagnostics.AddAttributeError(
+						metricPath.AtName("http").AtName("method"),
+						"Invalid http provider",
+						fmt.Sprintf("method must be one of GET, POST, PUT, PATCH, DELETE, HEAD or OPTIONS, got %q.", metric.HTTP.Method.ValueString()),
+					)
+				}
+			}
+
+			if !metric.Count.IsUnknown() && !metric.Count.IsNull() && metric.Count.ValueInt64() <= 0 {
+				resp.Diagnostics.AddAttributeError(metricPath.AtName("count"), "Invalid verification metric", "Metric count must be greater than zero.")
+			}
+
+			if metric.Sleep != nil && !metric.Sleep.DurationSeconds.IsUnknown() && !metric.Sleep.DurationSeconds.IsNull() {
+				seconds := metric.Sleep.DurationSeconds.ValueInt64()
+				if seconds < 1 || seconds > 3600 {
+					resp.Diagnostics.AddAttributeError(
+						metricPath.AtName("sleep").AtName("duration_seconds"),
+						"Invalid sleep provider",
+						fmt.Sprintf("duration_seconds must be between 1 and 3600, got %d.", seconds),
+					)
+				}
+			}
+		}
+	}
+}
+
+// policyGradualRolloutValidator checks rollout_type against the API enum and
+// requires a positive time_scale_interval.
+type policyGradualRolloutValidator struct{}
+
+func (policyGradualRolloutValidator) Description(_ context.Context) string {
+	return "Gradual rollout rules must use a supported rollout_type and a positive time_scale_interval."
+}
+
+func (v policyGradualRolloutValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (policyGradualRolloutValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	rollouts, paths, diags := policyRuleElements[PolicyGradualRollout](ctx, req.Config, "gradual_rollout")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, rollout := range rollouts {
+		rulePath := paths[i]
+
+		if !rollout.RolloutType.IsUnknown() && !rollout.RolloutType.IsNull() {
+			switch api.GradualRolloutRuleRolloutType(rollout.RolloutType.ValueString()) {
+			case api.GradualRolloutRuleRolloutTypeLinear, api.GradualRolloutRuleRolloutTypeLinearNormalized:
+			default:
+				resp.Diagnostics.AddAttributeError(
+					rulePath.AtName("rollout_type"),
+					"Invalid gradual rollout rule",
+					fmt.Sprintf("rollout_type must be %q or %q, got %q.", api.GradualRolloutRuleRolloutTypeLinear, api.GradualRolloutRuleRolloutTypeLinearNormalized, rollout.RolloutType.ValueString()),
+				)
+			}
+		}
+
+		if !rollout.TimeScaleInterval.IsUnknown() && !rollout.TimeScaleInterval.IsNull() && rollout.TimeScaleInterval.ValueInt64() <= 0 {
+			resp.Diagnostics.AddAttributeError(rulePath.AtName("time_scale_interval"), "Invalid gradual rollout rule", "time_scale_interval must be greater than zero.")
+		}
+	}
+}
+
+// policyDeploymentWindowValidator rejects unknown timezones and warns when a
+// window's local-time recurrence will move relative to UTC across daylight
+// saving transitions, or when daily windows run past midnight.
+type policyDeploymentWindowValidator struct{}
+
+func (policyDeploymentWindowValidator) Description(_ context.Context) string {
+	return "Deployment window timezones must be valid IANA names; windows in timezones with daylight saving time produce a warning unless lock_to_utc is set, as do daily windows that run past midnight."
+}
+
+func (v policyDeploymentWindowValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (policyDeploymentWindowValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	windows, paths, diags := policyRuleElements[PolicyDeploymentWindow](ctx, req.Config, "deployment_window")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, window := range windows {
+		rulePath := paths[i]
+		if window.Timezone.IsUnknown() || window.LockToUTC.IsUnknown() {
+			continue
+		}
+
+		location := time.UTC
+		shift := time.Duration(0)
+		timezone := window.Timezone.ValueString()
+		switch {
+		case window.Timezone.IsNull():
+		case window.LockToUTC.ValueBool():
+			if timezone != "UTC" {
+				resp.Diagnostics.AddAttributeError(rulePath.AtName("timezone"), "Invalid deployment window", "timezone must be UTC or unset when lock_to_utc is true.")
+				continue
+			}
+		default:
+			loaded, err := time.LoadLocation(timezone)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(rulePath.AtName("timezone"), "Invalid deployment window", fmt.Sprintf("Unknown IANA timezone %q.", timezone))
+				continue
+			}
+			location = loaded
+			shift = dstShift(location, time.Now().Year())
+		}
+
+		if window.Rrule.IsUnknown() {
+			continue
+		}
+		if shift != 0 && rruleShiftsWithDST(window.Rrule.ValueString()) {
+			resp.Diagnostics.AddAttributeWarning(
+				rulePath.AtName("timezone"),
+				"Deployment window shifts with daylight saving time",
+				fmt.Sprintf(
+					"%s observes daylight saving time, so windows from this rrule keep their local start time and move by %s in UTC across transitions. "+
+						"Set lock_to_utc = true to keep the window fixed in UTC.",
+					timezone, shift,
+				),
+			)
+		}
+
+		if window.DurationMinutes.IsUnknown() || window.DurationMinutes.IsNull() || window.AllowWindow.IsUnknown() {
+			continue
+		}
+		starts := rruleDailyStarts(window.Rrule.ValueString())
+		if detail := midnightWindowDetail(starts, window.DurationMinutes.ValueInt64(), location.String(), defaultBool(window.AllowWindow, true), shift); detail != "" {
+			resp.Diagnostics.AddAttributeWarning(rulePath.AtName("duration_minutes"), "Deployment window crosses midnight", detail)
+		}
+	}
+}
+
+// policyVerificationWindowValidator warns when verification cannot finish
+// inside the shortest window that deployments are allowed in, since such a
+// policy blocks every rollout it applies to.
+type policyVerificationWindowValidator struct{}
+
+func (policyVerificationWindowValidator) Description(_ context.Context) string {
+	return "Verification that takes longer than an allow deployment window produces a warning."
+}
+
+func (v policyVerificationWindowValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (policyVerificationWindowValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	windows, _, diags := policyRuleElements[PolicyDeploymentWindow](ctx, req.Config, "deployment_window")
+	resp.Diagnostics.Append(diags...)
+	verifications, paths, diags := policyRuleElements[PolicyVerificationRule](ctx, req.Config, "verification")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var shortest int64
+	for _, window := range windows {
+		if window.DurationMinutes.IsUnknown() || window.DurationMinutes.IsNull() || window.AllowWindow.IsUnknown() {
+			continue
+		}
+		if !defaultBool(window.AllowWindow, true) {
+			continue
+		}
+		if minutes := window.DurationMinutes.ValueInt64(); shortest == 0 || minutes < shortest {
+			shortest = minutes
+		}
+	}
+	if shortest == 0 {
+		return
+	}
+
+	for i, verification := range verifications {
+		estimate := verificationEstimatedDuration(verification.Metric)
+		seconds, err := parseDurationSeconds(estimate)
+		if err != nil || seconds <= shortest*60 {
+			continue
+		}
+		resp.Diagnostics.AddAttributeWarning(
+			paths[i],
+			"Verification outlasts deployment window",
+			fmt.Sprintf(
+				"Verification takes at least %s, but this policy only allows deployments in a %d minute window. "+
+					"Shorten the metric count or interval, or lengthen the window.",
+				estimate.ValueString(), shortest,
+			),
+		)
+	}
+}
+
+// policyRuleElements decodes the known elements of a policy rule block along
+// with the path of each element, so diagnostics point at the offending block.
+func policyRuleElements[T any](ctx context.Context, config tfsdk.Config, block string) ([]T, []path.Path, diag.Diagnostics) {
+	var list types.List
+	diags := config.GetAttribute(ctx, path.Root(block), &list)
+	if diags.HasError() || list.IsNull() || list.IsUnknown() {
+		return nil, nil, diags
+	}
+
+	var rules []T
+	var paths []path.Path
+	for i, element := range list.Elements() {
+		object, ok := element.(types.Object)
+		if !ok || object.IsUnknown() {
+			continue
+		}
+		var rule T
+		diags.Append(object.As(ctx, &rule, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, nil, diags
+		}
+		rules = append(rules, rule)
+		paths = append(paths, path.Root(block).AtListIndex(i))
+	}
+	return rules, paths, diags
+}
+
+// rruleShiftsWithDST reports whether occurrences of rule are anchored to a
+// local wall-clock time. Sub-daily frequencies repeat regardless of offset.
+// Rules that do not parse are left to the rrule validator.
+func rruleShiftsWithDST(rule string) bool {
+	recurrence, err := ctrlplanevalidator.ParseRRule(rule)
+	if err != nil {
+		return false
+	}
+	switch recurrence.Options.Freq {
+	case rrule.SECONDLY, rrule.MINUTELY, rrule.HOURLY:
+		return false
+	}
+	return true
+}
+
+// dstShift returns the difference between location's summer and winter UTC
+// offsets in year, or zero when the location does not observe DST.
+func dstShift(location *time.Location, year int) time.Duration {
+	_, january := time.Date(year, time.January, 1, 12, 0, 0, 0, location).Zone()
+	_, july := time.Date(year, time.July, 1, 12, 0, 0, 0, location).Zone()
+	shift := time.Duration(july-january) * time.Second
+	if shift < 0 {
+		shift = -shift
+	}
+	return shift
+}
+
+// rruleDailyStarts returns the local start times, in minutes after midnight,
+// of a FREQ=DAILY rule that repeats every day. The hours and minutes come from
+// BYHOUR and BYMINUTE, or from DTSTART when those are not set. It returns nil
+// for other rules, for rules that skip days, and for rules without BYHOUR or
+// DTSTART, whose start time the server picks.
+func rruleDailyStarts(rule string) []int {
+	recurrence, err := ctrlplanevalidator.ParseRRule(rule)
+	if err != nil {
+		return nil
+	}
+	options := recurrence.Options
+	if options.Freq != rrule.DAILY || options.Interval > 1 ||
+		len(options.Byweekday) > 0 || len(options.Bymonth) > 0 || len(options.Bymonthday) > 0 ||
+		len(options.Byyearday) > 0 || len(options.Bysetpos) > 0 {
+		return nil
+	}
+
+	hours, minutes := options.Byhour, options.Byminute
+	if !recurrence.DTStart.IsZero() {
+		if len(hours) == 0 {
+			hours = []int{recurrence.DTStart.Hour()}
+		}
+		if len(minutes) == 0 {
+			minutes = []int{recurrence.DTStart.Minute()}
+		}
+	}
+	if len(hours) == 0 {
+		return nil
+	}
+	if len(minutes) == 0 {
+		minutes = []int{0}
+	}
+
+	var starts []int
+	for _, hour := range hours {
+		for _, minute := range minutes {
+			starts = append(starts, hour*60+minute)
+		}
+	}
+	sort.Ints(starts)
+	return slices.Compact(starts)
+}
+
+// midnightWindowDetail describes the daily coverage of windows that start at
+// starts and last duration minutes, or returns "" when none of them runs past
+// midnight or together they cover the whole day. A window that runs past
+// midnight covers the early hours of the next day rather than extending the
+// current one, which often leaves a gap the configuration did not intend.
+func midnightWindowDetail(starts []int, duration int64, timezone string, allow bool, shift time.Duration) string {
+	const day = 24 * 60
+	var covered [day]bool
+	var crossing []int
+	for _, start := range starts {
+		if int64(start)+duration > day {
+			crossing = append(crossing, start)
+		}
+		for minute := int64(0); minute < duration && minute < day; minute++ {
+			covered[(int64(start)+minute)%day] = true
+		}
+	}
+
+	var spans []string
+	total := 0
+	for minute := 0; minute < day; {
+		if !covered[minute] {
+			minute++
+			continue
+		}
+		end := minute
+		for end < day && covered[end] {
+			end++
+		}
+		spans = append(spans, fmt.Sprintf("%s–%s", clockTime(minute), clockTime(end)))
+		total += end - minute
+		minute = end
+	}
+	if len(crossing) == 0 || total == day {
+		return ""
+	}
+
+	ends := make([]string, len(crossing))
+	for i, start := range crossing {
+		ends[i] = fmt.Sprintf("%s to %s", clockTime(start), clockTime(int((int64(start)+duration)%day)))
+	}
+	effect := "allowed"
+	if !allow {
+		effect = "denied"
+	}
+	detail := fmt.Sprintf(
+		"Windows of %d minutes run past midnight in %s (%s the next day). "+
+			"Each day is covered %s, %s of 24h (%.0f%%), and deployments are %s only then. "+
+			"The hours after midnight belong to the previous day's window, not an extension of the current one; "+
+			"if a continuous window was intended, check that its start time and duration_minutes cover it.",
+		duration, timezone, strings.Join(ends, ", "),
+		strings.Join(spans, ", "), time.Duration(total)*time.Minute, float64(total)*100/day, effect,
+	)
+	if shift != 0 {
+		detail += fmt.Sprintf(
+			" On nights when daylight saving time starts or ends, windows still last %d minutes, so they end %s earlier or later in local time.",
+			duration, shift,
+		)
+	}
+	return detail
+}
+
+// clockTime formats minutes after midnight as HH:MM, with 24:00 for the end
+// of the day.
+func clockTime(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
@@ -0,0 +1,63 @@
+// Copyright IBM Corp. 2021, 2026
+
+package provider
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestRRuleDailyStarts(t *testing.T) {
+	cases := map[string]struct {
+		rule string
+		want []int
+	}{
+		"byhour":                 {rule: "FREQ=DAILY;BYHOUR=22", want: []int{22 * 60}},
+		"byhour and byminute":    {rule: "RRULE:FREQ=DAILY;BYHOUR=9,22;BYMINUTE=30", want: []int{9*60 + 30, 22*60 + 30}},
+		"dtstart":                {rule: "DTSTART:20000101T213000\nRRULE:FREQ=DAILY", want: []int{21*60 + 30}},
+		"byhour overrides start": {rule: "DTSTART:20000101T213000\nRRULE:FREQ=DAILY;BYHOUR=8", want: []int{8*60 + 30}},
+		"no start time":          {rule: "FREQ=DAILY"},
+		"weekly":                 {rule: "FREQ=WEEKLY;BYHOUR=22"},
+		"every other day":        {rule: "FREQ=DAILY;INTERVAL=2;BYHOUR=22"},
+		"weekdays only":          {rule: "FREQ=DAILY;BYDAY=MO,TU;BYHOUR=22"},
+		"invalid":                {rule: "FREQ=DAILY;BYHOUR=25"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := rruleDailyStarts(tc.rule); !slices.Equal(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRRuleShiftsWithDST(t *testing.T) {
+	for rule, want := range map[string]bool{
+		"FREQ=DAILY;BYHOUR=9": true,
+		"DTSTART:20000101T160000\nRRULE:FREQ=WEEKLY;BYDAY=MO": true,
+		"RRULE:FREQ=HOURLY":                            false,
+		"DTSTART:20000101T160000\nRRULE:FREQ=MINUTELY": false,
+	} {
+		if got := rruleShiftsWithDST(rule); got != want {
+			t.Errorf("rruleShiftsWithDST(%q) = %v, want %v", rule, got, want)
+		}
+	}
+}
+
+func TestMidnightWindowDetail(t *testing.T) {
+	if got := midnightWindowDetail([]int{9 * 60}, 240, "UTC", true, 0); got != "" {
+		t.Errorf("window within the day: got %q, want no warning", got)
+	}
+	if got := midnightWindowDetail([]int{0, 12 * 60}, 720, "UTC", true, 0); got != "" {
+		t.Errorf("windows covering the whole day: got %q, want no warning", got)
+	}
+
+	got := midnightWindowDetail([]int{22 * 60}, 240, "America/New_York", false, 0)
+	for _, want := range []string{"22:00 to 02:00 the next day", "00:00–02:00, 22:00–24:00", "4h0m0s of 24h (17%)", "denied only then"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("got %q, want it to contain %q", got, want)
+		}
+	}
+}
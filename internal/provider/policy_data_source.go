@@ -0,0 +1,292 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ctrlplanedev/terraform-provider-ctrlplane/internal/api"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &PolicyDataSource{}
+var _ datasource.DataSourceWithConfigure = &PolicyDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &PolicyDataSource{}
+
+func NewPolicyDataSource() datasource.DataSource {
+	return &PolicyDataSource{}
+}
+
+type PolicyDataSource struct {
+	workspace *api.WorkspaceClient
+}
+
+type PolicyDataSourceModel struct {
+	ID          types.String           `tfsdk:"id"`
+	Name        types.String           `tfsdk:"name"`
+	Description types.String           `tfsdk:"description"`
+	Selector    types.String           `tfsdk:"selector"`
+	Priority    types.Int64            `tfsdk:"priority"`
+	Enabled     types.Bool             `tfsdk:"enabled"`
+	Metadata    types.Map              `tfsdk:"metadata"`
+	Rules       []PolicyDataSourceRule `tfsdk:"rules"`
+	AppURL      types.String           `tfsdk:"app_url"`
+	EntityURL   types.String           `tfsdk:"entity_url"`
+}
+
+type PolicyDataSourceRule struct {
+	ID     types.String `tfsdk:"id"`
+	Type   types.String `tfsdk:"type"`
+	Config types.String `tfsdk:"config"`
+}
+
+func (d *PolicyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy"
+}
+
+func (d *PolicyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetch an existing policy by ID or name within the configured workspace.",
+		Attributes: map[string]schema.Attribute{
+			"app_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "Link to the workspace in the Ctrlplane UI",
+			},
+			"entity_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "Link to this policy in the Ctrlplane UI",
+			},
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the policy to look up. Exactly one of id or name must be set.",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the policy to look up. Exactly one of id or name must be set.",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "The description of the policy",
+			},
+			"selector": schema.StringAttribute{
+				Computed:    true,
+				Description: "CEL expression for matching release targets",
+			},
+			"priority": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The priority of the policy",
+			},
+			"enabled": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the policy is enabled",
+			},
+			"metadata": schema.MapAttribute{
+				Computed:    true,
+				Description: "The metadata of the policy",
+				ElementType: types.StringType,
+			},
+			"rules": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The rules attached to the policy",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Rule ID",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Rule type, matching the ctrlplane_policy block name (e.g. \"version_selector\", \"gradual_rollout\")",
+						},
+						"config": schema.StringAttribute{
+							Computed:    true,
+							Description: "Rule configuration as returned by the API, JSON encoded. Use jsondecode() to read individual fields.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PolicyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	workspace, ok := req.ProviderData.(*api.WorkspaceClient)
+	if !ok {
+		resp.Diagnostics.AddError("Invalid provider data", "The provider data is not a *api.WorkspaceClient")
+		return
+	}
+
+	d.workspace = workspace
+}
+
+func (d *PolicyDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data PolicyDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.IsUnknown() || data.Name.IsUnknown() {
+		return
+	}
+
+	if data.ID.IsNull() == data.Name.IsNull() {
+		resp.Diagnostics.AddError("Invalid policy lookup", "Exactly one of id or name must be set.")
+	}
+}
+
+func (d *PolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PolicyDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var policy *api.Policy
+	if !data.ID.IsNull() {
+		policyResp, err := d.workspace.Client.GetPolicyWithResponse(ctx, d.workspace.ID.String(), data.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to read policy",
+				fmt.Sprintf("Failed to read policy with ID '%s': %s", data.ID.ValueString(), err.Error()),
+			)
+			return
+		}
+		if policyResp.StatusCode() == http.StatusNotFound {
+			resp.Diagnostics.AddError(
+				"Policy not found",
+				fmt.Sprintf("No policy with ID '%s' in workspace '%s'", data.ID.ValueString(), d.workspace.ID.String()),
+			)
+			return
+		}
+		if policyResp.StatusCode() != http.StatusOK || policyResp.JSON200 == nil {
+			resp.Diagnostics.AddError("Failed to read policy", formatResponseError(policyResp.HTTPResponse, policyResp.Body))
+			return
+		}
+		policy = policyResp.JSON200
+	} else {
+		found, err := findPolicyByName(ctx, d.workspace, data.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read policy", err.Error())
+			return
+		}
+		policy = found
+	}
+
+	data.ID = types.StringValue(policy.Id)
+	data.Name = types.StringValue(policy.Name)
+	data.Description = descriptionValue(policy.Description)
+	data.Selector = types.StringValue(policy.Selector)
+	data.Priority = types.Int64Value(int64(policy.Priority))
+	data.Enabled = types.BoolValue(policy.Enabled)
+	data.Metadata = stringMapValue(&policy.Metadata)
+
+	rules, err := policyDataSourceRules(policy.Rules)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read policy", err.Error())
+		return
+	}
+	data.Rules = rules
+
+	data.AppURL, data.EntityURL = entityURLs(d.workspace, "policies", data.ID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// findPolicyByName returns the only policy with the given name, or an error
+// when there is none or the name is ambiguous.
+func findPolicyByName(ctx context.Context, workspace *api.WorkspaceClient, name string) (*api.Policy, error) {
+	var matches []api.Policy
+	for policy, err := range api.Paginate(ctx, policyPages(workspace)) {
+		if err != nil {
+			return nil, err
+		}
+		if policy.Name == name {
+			matches = append(matches, policy)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no policy with name '%s' in workspace '%s'", name, workspace.ID.String())
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, fmt.Errorf("%d policies named '%s' in workspace '%s'; look up by id instead", len(matches), name, workspace.ID.String())
+	}
+}
+
+func policyDataSourceRules(rules []api.PolicyRule) ([]PolicyDataSourceRule, error) {
+	result := make([]PolicyDataSourceRule, 0, len(rules))
+	for _, rule := range rules {
+		ruleType, config := policyRuleTypeAndConfig(rule)
+		if ruleType == "" {
+			continue
+		}
+		encoded, err := json.Marshal(config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode %s rule %s: %w", ruleType, rule.Id, err)
+		}
+		result = append(result, PolicyDataSourceRule{
+			ID:     types.StringValue(rule.Id),
+			Type:   types.StringValue(ruleType),
+			Config: types.StringValue(string(encoded)),
+		})
+	}
+	return result, nil
+}
+
+func policyRuleTypeAndConfig(rule api.PolicyRule) (string, any) {
+	switch {
+	case rule.VersionSelector != nil:
+		return "version_selector", rule.VersionSelector
+	case rule.VersionCooldown != nil:
+		return "version_cooldown", rule.VersionCooldown
+	case rule.DeploymentWindow != nil:
+		return "deployment_window", rule.DeploymentWindow
+	case rule.DeploymentDependency != nil:
+		return "deployment_dependency", rule.DeploymentDependency
+	case rule.Verification != nil:
+		return "verification", rule.Verification
+	case rule.GradualRollout != nil:
+		return "gradual_rollout", rule.GradualRollout
+	case rule.AnyApproval != nil:
+		return "any_approval", rule.AnyApproval
+	case rule.EnvironmentProgression != nil:
+		return "environment_progression", rule.EnvironmentProgression
+	case rule.PlanValidationOpa != nil:
+		return "plan_validation_opa", rule.PlanValidationOpa
+	case rule.Retry != nil:
+		return "retry", rule.Retry
+	default:
+		return "", nil
+	}
+}
+
+func policyPages(workspace *api.WorkspaceClient) api.PageFunc[api.Policy] {
+	return func(ctx context.Context, limit, offset int) (api.Page[api.Policy], error) {
+		listResp, err := workspace.Client.ListPoliciesWithResponse(ctx, workspace.ID.String(), &api.ListPoliciesParams{
+			Limit:  &limit,
+			Offset: &offset,
+		})
+		if err != nil {
+			return api.Page[api.Policy]{}, fmt.Errorf("failed to list policies: %w", err)
+		}
+		if listResp.StatusCode() != http.StatusOK || listResp.JSON200 == nil {
+			return api.Page[api.Policy]{}, fmt.Errorf("%s", formatResponseError(listResp.HTTPResponse, listResp.Body))
+		}
+		return api.Page[api.Policy]{Items: listResp.JSON200.Items, Total: listResp.JSON200.Total}, nil
+	}
+}
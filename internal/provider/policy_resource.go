@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sort"
 	"time"
 
 	"github.com/ctrlplanedev/terraform-provider-ctrlplane/internal/api"
@@ -17,9 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -28,6 +27,7 @@ import (
 var _ resource.Resource = &PolicyResource{}
 var _ resource.ResourceWithImportState = &PolicyResource{}
 var _ resource.ResourceWithConfigure = &PolicyResource{}
+var _ resource.ResourceWithModifyPlan = &PolicyResource{}
 var _ resource.ResourceWithValidateConfig = &PolicyResource{}
 
 func NewPolicyResource() resource.Resource {
@@ -43,7 +43,7 @@ func (r *PolicyResource) Metadata(ctx context.Context, req resource.MetadataRequ
 }
 
 func (r *PolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByNaturalKey(ctx, r.workspace, req, resp, resolvePolicyName(r.workspace))
 }
 
 func (r *PolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -70,6 +70,24 @@ func (r *PolicyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"app_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "Link to the workspace in the Ctrlplane UI",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"entity_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "Link to this policy in the Ctrlplane UI",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"spec_json": schema.StringAttribute{
+				Computed:    true,
+				Description: "The complete policy as JSON for policy-as-code review: rule blocks grouped under \"rules\" by block name, IDs and timestamps omitted, and credentials redacted",
+			},
 			"name": schema.StringAttribute{
 				Required:    true,
 				Description: "The name of the policy",
@@ -78,16 +96,7 @@ func (r *PolicyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Optional:    true,
 				Description: "The description of the policy",
 			},
-			"metadata": schema.MapAttribute{
-				Optional:    true,
-				Computed:    true,
-				Description: "The metadata of the policy",
-				ElementType: types.StringType,
-				Default: func() defaults.Map {
-					empty, _ := types.MapValueFrom(context.Background(), types.StringType, map[string]string{})
-					return mapdefault.StaticValue(empty)
-				}(),
-			},
+			"metadata": metadataAttribute("The metadata of the policy"),
 			"priority": schema.Int64Attribute{
 				Optional:    true,
 				Computed:    true,
@@ -101,11 +110,19 @@ func (r *PolicyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Default:     booldefault.StaticBool(true),
 			},
 			"selector": schema.StringAttribute{
-				Required:    true,
-				Description: "CEL expression for matching release targets. Use \"true\" to match all targets.",
+				Optional:    true,
+				Computed:    true,
+				Description: "CEL expression for matching release targets. Use \"true\" to match all targets. Conflicts with system_ids, from which it is computed otherwise.",
+				Validators:  celValidators(),
+			},
+			"system_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Apply the policy to the deployments linked to these systems instead of writing a selector. The provider compiles the IDs of those deployments into selector, so deployments linked to a system later are picked up on the next apply. Conflicts with selector.",
 			},
 		},
 		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
 			"version_selector": schema.ListNestedBlock{
 				Description: "Version selector rules to filter which deployment versions are allowed",
 				NestedObject: schema.NestedBlockObject{
@@ -113,21 +130,16 @@ func (r *PolicyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 						"created_at": schema.StringAttribute{
 							Computed:    true,
 							Description: "Rule creation timestamp",
-							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.UseStateForUnknown(),
-							},
 						},
 						"id": schema.StringAttribute{
 							Optional:    true,
 							Computed:    true,
 							Description: "Rule ID",
-							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.UseStateForUnknown(),
-							},
 						},
 						"selector": schema.StringAttribute{
 							Required:    true,
 							Description: "CEL expression to match allowed versions (has access to version, environment, resource, and deployment variables)",
+							Validators:  celValidators(),
 							PlanModifiers: []planmodifier.String{
 								celNormalized(),
 							},
@@ -146,19 +158,14 @@ func (r *PolicyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 						"created_at": schema.StringAttribute{
 							Computed:    true,
 							Description: "Rule creation timestamp",
-							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.UseStateForUnknown(),
-							},
 						},
 						"id": schema.StringAttribute{
 							Optional:    true,
 							Computed:    true,
 							Description: "Rule ID",
-							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.UseStateForUnknown(),
-							},
 						},
 						"duration": schema.StringAttribute{
+							CustomType:  DurationType{},
 							Required:    true,
 							Description: "Minimum duration between deployments (e.g., \"1h\")",
 						},
@@ -172,16 +179,10 @@ func (r *PolicyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 						"created_at": schema.StringAttribute{
 							Computed:    true,
 							Description: "Rule creation timestamp",
-							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.UseStateForUnknown(),
-							},
 						},
 						"id": schema.StringAttribute{
 							Computed:    true,
 							Description: "Rule ID",
-							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.UseStateForUnknown(),
-							},
 						},
 						"duration_minutes": schema.Int64Attribute{
 							Required:    true,
@@ -190,6 +191,7 @@ func (r *PolicyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 						"rrule": schema.StringAttribute{
 							Required:    true,
 							Description: "RFC 5545 recurrence rule for window starts",
+							Validators:  rruleValidators(),
 						},
 						"timezone": schema.StringAttribute{
 							Optional:    true,
@@ -201,6 +203,12 @@ func (r *PolicyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 							Description: "Allow deployments during the window (deny when false)",
 							Default:     booldefault.StaticBool(true),
 						},
+						"lock_to_utc": schema.BoolAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "Evaluate the recurrence rule in UTC so windows do not shift across daylight saving transitions. When true, timezone must be UTC or unset.",
+							Default:     booldefault.StaticBool(false),
+						},
 					},
 				},
 			},
@@ -211,21 +219,16 @@ func (r *PolicyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 						"created_at": schema.StringAttribute{
 							Computed:    true,
 							Description: "Rule creation timestamp",
-							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.UseStateForUnknown(),
-							},
 						},
 						"id": schema.StringAttribute{
 							Optional:    true,
 							Computed:    true,
 							Description: "Rule ID",
-							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.UseStateForUnknown(),
-							},
 						},
 						"depends_on_selector": schema.StringAttribute{
 							Required:    true,
 							Description: "CEL expression to match upstream deployment(s) that must have a successful release before this deployment can proceed",
+							Validators:  celValidators(),
 						},
 					},
 				},
@@ -237,22 +240,21 @@ func (r *PolicyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 						"created_at": schema.StringAttribute{
 							Computed:    true,
 							Description: "Rule creation timestamp",
-							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.UseStateForUnknown(),
-							},
 						},
 						"id": schema.StringAttribute{
 							Optional:    true,
 							Computed:    true,
 							Description: "Rule ID",
-							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.UseStateForUnknown(),
-							},
 						},
 						"trigger_on": schema.StringAttribute{
 							Optional:    true,
 							Description: "When to trigger verification (e.g., \"jobSuccess\")",
 						},
+						"estimated_duration": schema.StringAttribute{
+							CustomType:  DurationType{},
+							Computed:    true,
+							Description: "How long verification takes when every measurement passes: count × interval of the slowest metric",
+						},
 					},
 					Blocks: map[string]schema.Block{
 						"metric": schema.ListNestedBlock{
@@ -264,6 +266,7 @@ func (r *PolicyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										Description: "Metric name",
 									},
 									"interval": schema.StringAttribute{
+										CustomType:  DurationType{},
 										Required:    true,
 										Description: "Interval between measurements (e.g., \"30s\")",
 									},
@@ -318,6 +321,7 @@ func (r *PolicyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 												Description: "Datadog site URL (e.g., us5.datadoghq.com)",
 											},
 											"interval": schema.StringAttribute{
+												CustomType:  DurationType{},
 												Optional:    true,
 												Description: "Provider interval (e.g., \"1m\")",
 											},
@@ -346,6 +350,61 @@ func (r *PolicyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 											},
 										},
 									},
+									"http": schema.SingleNestedBlock{
+										Description: "HTTP metric provider configuration. The response is available to the success and failure conditions as result.",
+										Attributes: map[string]schema.Attribute{
+											"url": schema.StringAttribute{
+												Required:    true,
+												Description: "Endpoint URL (supports Go templates)",
+											},
+											"method": schema.StringAttribute{
+												Optional:    true,
+												Description: "HTTP method (e.g., \"GET\" or \"POST\"). Defaults to GET on the server.",
+											},
+											"headers": schema.MapAttribute{
+												Optional:    true,
+												Description: "HTTP headers (values support Go templates)",
+												ElementType: types.StringType,
+												Sensitive:   true,
+											},
+											"body": schema.StringAttribute{
+												Optional:    true,
+												Description: "Request body (supports Go templates)",
+											},
+											"timeout": schema.StringAttribute{
+												Optional:    true,
+												Description: "Request timeout as a duration (e.g., \"30s\")",
+											},
+										},
+									},
+									"prometheus": schema.SingleNestedBlock{
+										Description: "Prometheus metric provider configuration",
+										Attributes: map[string]schema.Attribute{
+											"address": schema.StringAttribute{
+												Required:    true,
+												Description: "Prometheus server URL (e.g., http://prometheus:9090)",
+											},
+											"query": schema.StringAttribute{
+												Required:    true,
+												Description: "PromQL query expression",
+											},
+											"bearer_token": schema.StringAttribute{
+												Optional:    true,
+												Description: "Bearer token sent with each query",
+												Sensitive:   true,
+											},
+											"headers": schema.MapAttribute{
+												Optional:    true,
+												Description: "Additional HTTP headers sent with each query",
+												ElementType: types.StringType,
+												Sensitive:   true,
+											},
+											"step": schema.StringAttribute{
+												Optional:    true,
+												Description: "Resolution step as a Prometheus duration (e.g., \"30s\"). When set, a range query is used instead of an instant query.",
+											},
+										},
+									},
 								},
 							},
 						},
@@ -359,16 +418,10 @@ func (r *PolicyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 						"created_at": schema.StringAttribute{
 							Computed:    true,
 							Description: "Rule creation timestamp",
-							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.UseStateForUnknown(),
-							},
 						},
 						"id": schema.StringAttribute{
 							Computed:    true,
 							Description: "Rule ID",
-							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.UseStateForUnknown(),
-							},
 						},
 						"rollout_type": schema.StringAttribute{
 							Required:    true,
@@ -388,16 +441,10 @@ func (r *PolicyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 						"created_at": schema.StringAttribute{
 							Computed:    true,
 							Description: "Rule creation timestamp",
-							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.UseStateForUnknown(),
-							},
 						},
 						"id": schema.StringAttribute{
 							Computed:    true,
 							Description: "Rule ID",
-							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.UseStateForUnknown(),
-							},
 						},
 						"min_approvals": schema.Int64Attribute{
 							Required:    true,
@@ -413,20 +460,15 @@ func (r *PolicyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 						"created_at": schema.StringAttribute{
 							Computed:    true,
 							Description: "Rule creation timestamp",
-							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.UseStateForUnknown(),
-							},
 						},
 						"id": schema.StringAttribute{
 							Computed:    true,
 							Description: "Rule ID",
-							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.UseStateForUnknown(),
-							},
 						},
 						"depends_on_environment_selector": schema.StringAttribute{
 							Required:    true,
 							Description: "CEL expression to match the environment that must have a successful release before this environment can proceed",
+							Validators:  celValidators(),
 							PlanModifiers: []planmodifier.String{
 								celNormalized(),
 							},
@@ -455,16 +497,10 @@ func (r *PolicyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 						"created_at": schema.StringAttribute{
 							Computed:    true,
 							Description: "Rule creation timestamp",
-							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.UseStateForUnknown(),
-							},
 						},
 						"id": schema.StringAttribute{
 							Computed:    true,
 							Description: "Rule ID",
-							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.UseStateForUnknown(),
-							},
 						},
 						"name": schema.StringAttribute{
 							Required:    true,
@@ -492,16 +528,37 @@ func (r *PolicyResource) ValidateConfig(ctx context.Context, req resource.Valida
 		return
 	}
 
-	if data.Selector.IsUnknown() {
+	if data.Selector.IsUnknown() || data.SystemIds.IsUnknown() {
+		return
+	}
+
+	if !data.SystemIds.IsNull() {
+		if !data.Selector.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("system_ids"), "Invalid policy configuration", "Only one of selector or system_ids may be set.")
+		}
 		return
 	}
 
 	if data.Selector.IsNull() || data.Selector.ValueString() == "" {
-		resp.Diagnostics.AddError("Invalid policy configuration", "The selector attribute must be set to a CEL expression.")
+		resp.Diagnostics.AddError("Invalid policy configuration", "The selector attribute must be set to a CEL expression, or system_ids to a list of systems.")
 		return
 	}
 }
 
+// resolvePlannedSelector computes the selector from system_ids when it was
+// left unknown at plan time because a system ID was not yet known.
+func (r *PolicyResource) resolvePlannedSelector(ctx context.Context, data *PolicyResourceModel) error {
+	if data.SystemIds.IsNull() || !data.Selector.IsUnknown() {
+		return nil
+	}
+	selector, err := policySystemSelector(ctx, r.workspace, data.SystemIds)
+	if err != nil {
+		return err
+	}
+	data.Selector = types.StringValue(selector)
+	return nil
+}
+
 func (r *PolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data PolicyResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -509,6 +566,14 @@ func (r *PolicyResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.create())
+	defer cancel()
+
+	if err := r.resolvePlannedSelector(ctx, &data); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("system_ids"), "Failed to create policy", err.Error())
+		return
+	}
+
 	rules, diags := policyRulesFromModel(data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -521,13 +586,13 @@ func (r *PolicyResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	policyID := uuid.NewString()
 	data.ID = types.StringValue(policyID)
-	ensurePolicyIDs(&data, nil)
-	ensurePolicyRuleCreatedAt(&data, nil)
+	ensurePolicyRuleIdentities(&data, nil)
+	fillVerificationEstimates(data.Verification)
 
 	requestBody := policyRequestPayload{
 		Name:        data.Name.ValueString(),
 		Description: data.Description.ValueStringPointer(),
-		Metadata:    stringMapPointer(data.Metadata),
+		Metadata:    metadataRequest(r.workspace, data.Metadata),
 		Priority:    &priority,
 		Enabled:     &enabled,
 		Rules:       &rules,
@@ -554,7 +619,7 @@ func (r *PolicyResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	if policyResp.StatusCode() != http.StatusAccepted {
-		resp.Diagnostics.AddError("Failed to create policy", formatResponseError(policyResp.StatusCode(), policyResp.Body))
+		addResponseError(ctx, &resp.Diagnostics, "Failed to create policy", req.Plan, policyResp.HTTPResponse, policyResp.Body)
 		return
 	}
 
@@ -570,7 +635,7 @@ func (r *PolicyResource) Create(ctx context.Context, req resource.CreateRequest,
 		updateBody := policyRequestPayload{
 			Name:        data.Name.ValueString(),
 			Description: data.Description.ValueStringPointer(),
-			Metadata:    stringMapPointer(data.Metadata),
+			Metadata:    metadataRequest(r.workspace, data.Metadata),
 			Priority:    &priority,
 			Enabled:     &enabled,
 			Rules:       &rules,
@@ -594,7 +659,7 @@ func (r *PolicyResource) Create(ctx context.Context, req resource.CreateRequest,
 			return
 		}
 		if updateResp.StatusCode() != http.StatusAccepted {
-			resp.Diagnostics.AddError("Failed to update policy", formatResponseError(updateResp.StatusCode(), updateResp.Body))
+			addResponseError(ctx, &resp.Diagnostics, "Failed to update policy", req.Plan, updateResp.HTTPResponse, updateResp.Body)
 			return
 		}
 	}
@@ -618,7 +683,12 @@ func (r *PolicyResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	data.AppURL, data.EntityURL = entityURLs(r.workspace, "policies", data.ID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(setPolicySpecJSON(ctx, &resp.State)...)
 }
 
 func (r *PolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -628,6 +698,9 @@ func (r *PolicyResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.read())
+	defer cancel()
+
 	policyResp, err := r.workspace.Client.GetPolicyWithResponse(ctx, r.workspace.ID.String(), data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read policy", err.Error())
@@ -644,7 +717,7 @@ func (r *PolicyResource) Read(ctx context.Context, req resource.ReadRequest, res
 		resp.State.RemoveResource(ctx)
 		return
 	default:
-		resp.Diagnostics.AddError("Failed to read policy", formatResponseError(policyResp.StatusCode(), policyResp.Body))
+		resp.Diagnostics.AddError("Failed to read policy", formatResponseError(policyResp.HTTPResponse, policyResp.Body))
 		return
 	}
 
@@ -652,7 +725,7 @@ func (r *PolicyResource) Read(ctx context.Context, req resource.ReadRequest, res
 	data.ID = types.StringValue(policy.Id)
 	data.Name = types.StringValue(policy.Name)
 	data.Description = descriptionValue(policy.Description)
-	data.Metadata = stringMapValue(&policy.Metadata)
+	data.Metadata = metadataFromAPI(r.workspace, &policy.Metadata, data.Metadata)
 	data.Priority = types.Int64Value(int64(policy.Priority))
 	data.Enabled = types.BoolValue(policy.Enabled)
 
@@ -663,8 +736,10 @@ func (r *PolicyResource) Read(ctx context.Context, req resource.ReadRequest, res
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	orderPolicyRules(&rules, data)
 	data.VersionSelector = rules.VersionSelector
 	data.VersionCooldown = rules.VersionCooldown
+	mergeWindowLockToUTC(rules.DeploymentWindow, data.DeploymentWindow)
 	data.DeploymentWindow = rules.DeploymentWindow
 	data.DeploymentDependency = rules.DeploymentDependency
 	data.Verification = rules.Verification
@@ -673,7 +748,12 @@ func (r *PolicyResource) Read(ctx context.Context, req resource.ReadRequest, res
 	data.EnvironmentProgression = rules.EnvironmentProgression
 	data.PlanValidationOpa = rules.PlanValidationOpa
 
+	data.AppURL, data.EntityURL = entityURLs(r.workspace, "policies", data.ID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(setPolicySpecJSON(ctx, &resp.State)...)
 }
 
 func (r *PolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -686,9 +766,17 @@ func (r *PolicyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.update())
+	defer cancel()
+
+	if err := r.resolvePlannedSelector(ctx, &data); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("system_ids"), "Failed to update policy", err.Error())
+		return
+	}
+
 	data.ID = state.ID
-	ensurePolicyIDs(&data, &state)
-	ensurePolicyRuleCreatedAt(&data, &state)
+	ensurePolicyRuleIdentities(&data, &state)
+	fillVerificationEstimates(data.Verification)
 
 	rules, diags := policyRulesFromModel(data)
 	resp.Diagnostics.Append(diags...)
@@ -703,7 +791,7 @@ func (r *PolicyResource) Update(ctx context.Context, req resource.UpdateRequest,
 	requestBody := policyRequestPayload{
 		Name:        data.Name.ValueString(),
 		Description: data.Description.ValueStringPointer(),
-		Metadata:    stringMapPointer(data.Metadata),
+		Metadata:    metadataRequest(r.workspace, data.Metadata),
 		Priority:    &priority,
 		Enabled:     &enabled,
 		Rules:       &rules,
@@ -731,7 +819,7 @@ func (r *PolicyResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	if policyResp.StatusCode() != http.StatusAccepted {
-		resp.Diagnostics.AddError("Failed to update policy", formatResponseError(policyResp.StatusCode(), policyResp.Body))
+		addResponseError(ctx, &resp.Diagnostics, "Failed to update policy", req.Plan, policyResp.HTTPResponse, policyResp.Body)
 		return
 	}
 
@@ -744,7 +832,7 @@ func (r *PolicyResource) Update(ctx context.Context, req resource.UpdateRequest,
 	data.ID = types.StringValue(policy.Id)
 	data.Name = types.StringValue(policy.Name)
 	data.Description = descriptionValue(policy.Description)
-	data.Metadata = stringMapValue(&policy.Metadata)
+	data.Metadata = metadataFromAPI(r.workspace, &policy.Metadata, data.Metadata)
 	data.Priority = types.Int64Value(int64(policy.Priority))
 	data.Enabled = types.BoolValue(policy.Enabled)
 	data.Selector = types.StringValue(policy.Selector)
@@ -754,8 +842,10 @@ func (r *PolicyResource) Update(ctx context.Context, req resource.UpdateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	orderPolicyRules(&readRules, data)
 	data.VersionSelector = readRules.VersionSelector
 	data.VersionCooldown = readRules.VersionCooldown
+	mergeWindowLockToUTC(readRules.DeploymentWindow, data.DeploymentWindow)
 	data.DeploymentWindow = readRules.DeploymentWindow
 	data.DeploymentDependency = readRules.DeploymentDependency
 	data.Verification = readRules.Verification
@@ -764,7 +854,12 @@ func (r *PolicyResource) Update(ctx context.Context, req resource.UpdateRequest,
 	data.EnvironmentProgression = readRules.EnvironmentProgression
 	data.PlanValidationOpa = readRules.PlanValidationOpa
 
+	data.AppURL, data.EntityURL = entityURLs(r.workspace, "policies", data.ID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(setPolicySpecJSON(ctx, &resp.State)...)
 }
 
 func (r *PolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -774,6 +869,9 @@ func (r *PolicyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.delete())
+	defer cancel()
+
 	policyResp, err := r.workspace.Client.RequestPolicyDeletionWithResponse(ctx, r.workspace.ID.String(), data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to delete policy", err.Error())
@@ -787,7 +885,7 @@ func (r *PolicyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		resp.State.RemoveResource(ctx)
 		return
 	default:
-		resp.Diagnostics.AddError("Failed to delete policy", formatResponseError(policyResp.StatusCode(), policyResp.Body))
+		resp.Diagnostics.AddError("Failed to delete policy", formatResponseError(policyResp.HTTPResponse, policyResp.Body))
 		return
 	}
 }
@@ -796,10 +894,11 @@ type PolicyResourceModel struct {
 	ID                     types.String                   `tfsdk:"id"`
 	Name                   types.String                   `tfsdk:"name"`
 	Description            types.String                   `tfsdk:"description"`
-	Metadata               types.Map                      `tfsdk:"metadata"`
+	Metadata               MetadataValue                  `tfsdk:"metadata"`
 	Priority               types.Int64                    `tfsdk:"priority"`
 	Enabled                types.Bool                     `tfsdk:"enabled"`
 	Selector               types.String                   `tfsdk:"selector"`
+	SystemIds              types.Set                      `tfsdk:"system_ids"`
 	VersionSelector        []PolicyVersionSelector        `tfsdk:"version_selector"`
 	VersionCooldown        []PolicyVersionCooldown        `tfsdk:"version_cooldown"`
 	DeploymentWindow       []PolicyDeploymentWindow       `tfsdk:"deployment_window"`
@@ -809,6 +908,10 @@ type PolicyResourceModel struct {
 	AnyApproval            []PolicyAnyApproval            `tfsdk:"any_approval"`
 	EnvironmentProgression []PolicyEnvironmentProgression `tfsdk:"environment_progression"`
 	PlanValidationOpa      []PolicyPlanValidationOpa      `tfsdk:"plan_validation_opa"`
+	AppURL                 types.String                   `tfsdk:"app_url"`
+	EntityURL              types.String                   `tfsdk:"entity_url"`
+	SpecJSON               types.String                   `tfsdk:"spec_json"`
+	Timeouts               *resourceTimeouts              `tfsdk:"timeouts"`
 }
 
 type PolicyVersionSelector struct {
@@ -819,9 +922,9 @@ type PolicyVersionSelector struct {
 }
 
 type PolicyVersionCooldown struct {
-	CreatedAt types.String `tfsdk:"created_at"`
-	ID        types.String `tfsdk:"id"`
-	Duration  types.String `tfsdk:"duration"`
+	CreatedAt types.String  `tfsdk:"created_at"`
+	ID        types.String  `tfsdk:"id"`
+	Duration  DurationValue `tfsdk:"duration"`
 }
 
 type PolicyDeploymentWindow struct {
@@ -831,6 +934,7 @@ type PolicyDeploymentWindow struct {
 	Rrule           types.String `tfsdk:"rrule"`
 	Timezone        types.String `tfsdk:"timezone"`
 	AllowWindow     types.Bool   `tfsdk:"allow_window"`
+	LockToUTC       types.Bool   `tfsdk:"lock_to_utc"`
 }
 
 type PolicyDeploymentDependency struct {
@@ -862,10 +966,11 @@ type PolicyEnvironmentProgression struct {
 }
 
 type PolicyVerificationRule struct {
-	CreatedAt types.String               `tfsdk:"created_at"`
-	ID        types.String               `tfsdk:"id"`
-	TriggerOn types.String               `tfsdk:"trigger_on"`
-	Metric    []PolicyVerificationMetric `tfsdk:"metric"`
+	CreatedAt         types.String               `tfsdk:"created_at"`
+	ID                types.String               `tfsdk:"id"`
+	TriggerOn         types.String               `tfsdk:"trigger_on"`
+	EstimatedDuration DurationValue              `tfsdk:"estimated_duration"`
+	Metric            []PolicyVerificationMetric `tfsdk:"metric"`
 }
 
 type PolicyPlanValidationOpa struct {
@@ -877,13 +982,15 @@ type PolicyPlanValidationOpa struct {
 }
 
 type PolicyVerificationMetric struct {
-	Name     types.String                 `tfsdk:"name"`
-	Interval types.String                 `tfsdk:"interval"`
-	Count    types.Int64                  `tfsdk:"count"`
-	Success  *PolicyVerificationCondition `tfsdk:"success"`
-	Failure  *PolicyVerificationCondition `tfsdk:"failure"`
-	Sleep    *PolicySleepProvider         `tfsdk:"sleep"`
-	Datadog  *PolicyDatadogProvider       `tfsdk:"datadog"`
+	Name       types.String                 `tfsdk:"name"`
+	Interval   DurationValue                `tfsdk:"interval"`
+	Count      types.Int64                  `tfsdk:"count"`
+	Success    *PolicyVerificationCondition `tfsdk:"success"`
+	Failure    *PolicyVerificationCondition `tfsdk:"failure"`
+	Sleep      *PolicySleepProvider         `tfsdk:"sleep"`
+	Datadog    *PolicyDatadogProvider       `tfsdk:"datadog"`
+	Prometheus *PolicyPrometheusProvider    `tfsdk:"prometheus"`
+	HTTP       *PolicyHTTPProvider          `tfsdk:"http"`
 }
 
 type PolicySleepProvider struct {
@@ -895,14 +1002,30 @@ type PolicyVerificationCondition struct {
 	Threshold types.Int64  `tfsdk:"threshold"`
 }
 
+type PolicyHTTPProvider struct {
+	URL     types.String `tfsdk:"url"`
+	Method  types.String `tfsdk:"method"`
+	Headers types.Map    `tfsdk:"headers"`
+	Body    types.String `tfsdk:"body"`
+	Timeout types.String `tfsdk:"timeout"`
+}
+
+type PolicyPrometheusProvider struct {
+	Address     types.String `tfsdk:"address"`
+	Query       types.String `tfsdk:"query"`
+	BearerToken types.String `tfsdk:"bearer_token"`
+	Headers     types.Map    `tfsdk:"headers"`
+	Step        types.String `tfsdk:"step"`
+}
+
 type PolicyDatadogProvider struct {
-	Site       types.String `tfsdk:"site"`
-	Interval   types.String `tfsdk:"interval"`
-	Queries    types.Map    `tfsdk:"queries"`
-	ApiKey     types.String `tfsdk:"api_key"`
-	AppKey     types.String `tfsdk:"app_key"`
-	Aggregator types.String `tfsdk:"aggregator"`
-	Formula    types.String `tfsdk:"formula"`
+	Site       types.String  `tfsdk:"site"`
+	Interval   DurationValue `tfsdk:"interval"`
+	Queries    types.Map     `tfsdk:"queries"`
+	ApiKey     types.String  `tfsdk:"api_key"`
+	AppKey     types.String  `tfsdk:"app_key"`
+	Aggregator types.String  `tfsdk:"aggregator"`
+	Formula    types.String  `tfsdk:"formula"`
 }
 
 type policyRulesModel struct {
@@ -960,19 +1083,6 @@ func createdAtValue(value types.String) string {
 	return time.Now().UTC().Format(time.RFC3339)
 }
 
-func formatDuration(value time.Duration) string {
-	if value%time.Hour == 0 {
-		return fmt.Sprintf("%dh", int64(value/time.Hour))
-	}
-	if value%time.Minute == 0 {
-		return fmt.Sprintf("%dm", int64(value/time.Minute))
-	}
-	if value%time.Second == 0 {
-		return fmt.Sprintf("%ds", int64(value/time.Second))
-	}
-	return value.String()
-}
-
 func int64ValueSet(value types.Int64) bool {
 	return !value.IsNull() && !value.IsUnknown()
 }
@@ -1044,7 +1154,10 @@ func policyRulesFromModel(data PolicyResourceModel) ([]policyRequestRule, diag.D
 			DurationMinutes: int32(window.DurationMinutes.ValueInt64()),
 			Rrule:           window.Rrule.ValueString(),
 		}
-		if selectorValueSet(window.Timezone) {
+		if defaultBool(window.LockToUTC, false) {
+			timezone := "UTC"
+			rule.Timezone = &timezone
+		} else if selectorValueSet(window.Timezone) {
 			timezone := window.Timezone.ValueString()
 			rule.Timezone = &timezone
 		}
@@ -1158,11 +1271,10 @@ func policyRulesFromModel(data PolicyResourceModel) ([]policyRequestRule, diag.D
 	return rules, diags
 }
 
+// policyVerificationRuleFromModel converts a verification rule for the API.
+// The rule's structure is checked by policyVerificationValidator, which
+// Terraform runs again with known values before every apply.
 func policyVerificationRuleFromModel(model PolicyVerificationRule) (*api.VerificationRule, error) {
-	if len(model.Metric) == 0 {
-		return nil, fmt.Errorf("verification rule must define at least one metric")
-	}
-
 	metrics := make([]api.VerificationMetricSpec, 0, len(model.Metric))
 	for _, metric := range model.Metric {
 		spec, err := policyVerificationMetricFromModel(metric)
@@ -1185,28 +1297,12 @@ func policyVerificationRuleFromModel(model PolicyVerificationRule) (*api.Verific
 }
 
 func policyVerificationMetricFromModel(model PolicyVerificationMetric) (api.VerificationMetricSpec, error) {
-	if model.Success == nil {
-		return api.VerificationMetricSpec{}, fmt.Errorf("metric success block is required")
-	}
-
-	hasSleep := model.Sleep != nil
-	hasDatadog := model.Datadog != nil
-	if !hasSleep && !hasDatadog {
-		return api.VerificationMetricSpec{}, fmt.Errorf("exactly one of sleep or datadog provider block is required")
-	}
-	if hasSleep && hasDatadog {
-		return api.VerificationMetricSpec{}, fmt.Errorf("only one of sleep or datadog provider block can be set")
-	}
-
 	intervalSeconds, err := parseDurationSeconds(model.Interval)
 	if err != nil {
 		return api.VerificationMetricSpec{}, err
 	}
 
 	count := int(model.Count.ValueInt64())
-	if count <= 0 {
-		return api.VerificationMetricSpec{}, fmt.Errorf("metric count must be greater than zero")
-	}
 
 	successCondition := model.Success.Condition.ValueString()
 	if successCondition == "" {
@@ -1214,10 +1310,15 @@ func policyVerificationMetricFromModel(model PolicyVerificationMetric) (api.Veri
 	}
 
 	var provider api.MetricProvider
-	if hasSleep {
+	switch {
+	case model.Sleep != nil:
 		provider, err = policySleepProviderFromModel(*model.Sleep)
-	} else {
+	case model.Datadog != nil:
 		provider, err = policyDatadogProviderFromModel(*model.Datadog)
+	case model.HTTP != nil:
+		provider, err = policyHTTPProviderFromModel(*model.HTTP)
+	default:
+		provider, err = policyPrometheusProviderFromModel(*model.Prometheus)
 	}
 	if err != nil {
 		return api.VerificationMetricSpec{}, err
@@ -1249,9 +1350,6 @@ func policyVerificationMetricFromModel(model PolicyVerificationMetric) (api.Veri
 
 func policySleepProviderFromModel(model PolicySleepProvider) (api.MetricProvider, error) {
 	durationSeconds := defaultInt64(model.DurationSeconds, 30)
-	if durationSeconds < 1 || durationSeconds > 3600 {
-		return api.MetricProvider{}, fmt.Errorf("sleep duration_seconds must be between 1 and 3600, got %d", durationSeconds)
-	}
 
 	sleepProvider := api.SleepMetricProvider{
 		Type:            api.Sleep,
@@ -1293,7 +1391,7 @@ func policyDatadogProviderFromModel(model PolicyDatadogProvider) (api.MetricProv
 		site := model.Site.ValueString()
 		datadog.Site = &site
 	}
-	if selectorValueSet(model.Interval) {
+	if selectorValueSet(model.Interval.StringValue) {
 		intervalSeconds, err := parseDurationSeconds(model.Interval)
 		if err != nil {
 			return api.MetricProvider{}, err
@@ -1318,6 +1416,109 @@ func policyDatadogProviderFromModel(model PolicyDatadogProvider) (api.MetricProv
 	return provider, nil
 }
 
+func policyHTTPProviderFromModel(model PolicyHTTPProvider) (api.MetricProvider, error) {
+	if !selectorValueSet(model.URL) {
+		return api.MetricProvider{}, fmt.Errorf("http url is required")
+	}
+
+	httpProvider := api.HTTPMetricProvider{
+		Type: api.Http,
+		Url:  model.URL.ValueString(),
+	}
+
+	if selectorValueSet(model.Method) {
+		method := api.HTTPMetricProviderMethod(model.Method.ValueString())
+		httpProvider.Method = &method
+	}
+	if !model.Headers.IsNull() && !model.Headers.IsUnknown() {
+		headers, err := mapStringValue(model.Headers)
+		if err != nil {
+			return api.MetricProvider{}, fmt.Errorf("invalid http headers: %w", err)
+		}
+		httpProvider.Headers = &headers
+	}
+	if selectorValueSet(model.Body) {
+		body := model.Body.ValueString()
+		httpProvider.Body = &body
+	}
+	if selectorValueSet(model.Timeout) {
+		timeout := model.Timeout.ValueString()
+		httpProvider.Timeout = &timeout
+	}
+
+	var provider api.MetricProvider
+	if err := provider.FromHTTPMetricProvider(httpProvider); err != nil {
+		return api.MetricProvider{}, err
+	}
+
+	return provider, nil
+}
+
+func policyPrometheusProviderFromModel(model PolicyPrometheusProvider) (api.MetricProvider, error) {
+	if !selectorValueSet(model.Address) {
+		return api.MetricProvider{}, fmt.Errorf("prometheus address is required")
+	}
+	if !selectorValueSet(model.Query) {
+		return api.MetricProvider{}, fmt.Errorf("prometheus query is required")
+	}
+
+	prometheus := api.PrometheusMetricProvider{
+		Type:    api.Prometheus,
+		Address: model.Address.ValueString(),
+		Query:   model.Query.ValueString(),
+	}
+
+	if selectorValueSet(model.BearerToken) {
+		token := model.BearerToken.ValueString()
+		prometheus.Authentication = &struct {
+			BearerToken *string `json:"bearerToken,omitempty"`
+			Oauth2      *struct {
+				ClientId     string    `json:"clientId"`
+				ClientSecret string    `json:"clientSecret"`
+				Scopes       *[]string `json:"scopes,omitempty"`
+				TokenUrl     string    `json:"tokenUrl"`
+			} `json:"oauth2,omitempty"`
+		}{BearerToken: &token}
+	}
+	if !model.Headers.IsNull() && !model.Headers.IsUnknown() {
+		headers, err := mapStringValue(model.Headers)
+		if err != nil {
+			return api.MetricProvider{}, fmt.Errorf("invalid prometheus headers: %w", err)
+		}
+		names := make([]string, 0, len(headers))
+		for name := range headers {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		list := make([]struct {
+			Key   string `json:"key"`
+			Value string `json:"value"`
+		}, 0, len(names))
+		for _, name := range names {
+			list = append(list, struct {
+				Key   string `json:"key"`
+				Value string `json:"value"`
+			}{Key: name, Value: headers[name]})
+		}
+		prometheus.Headers = &list
+	}
+	if selectorValueSet(model.Step) {
+		prometheus.RangeQuery = &struct {
+			End   *string `json:"end,omitempty"`
+			Start *string `json:"start,omitempty"`
+			Step  string  `json:"step"`
+		}{Step: model.Step.ValueString()}
+	}
+
+	var provider api.MetricProvider
+	if err := provider.FromPrometheusMetricProvider(prometheus); err != nil {
+		return api.MetricProvider{}, err
+	}
+
+	return provider, nil
+}
+
 func policyRulesToModel(rules []api.PolicyRule) (policyRulesModel, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	result := policyRulesModel{}
@@ -1336,11 +1537,10 @@ func policyRulesToModel(rules []api.PolicyRule) (policyRulesModel, diag.Diagnost
 			result.VersionSelector = append(result.VersionSelector, model)
 		}
 		if rule.VersionCooldown != nil {
-			duration := time.Duration(rule.VersionCooldown.IntervalSeconds) * time.Second
 			result.VersionCooldown = append(result.VersionCooldown, PolicyVersionCooldown{
 				CreatedAt: types.StringValue(rule.CreatedAt),
 				ID:        types.StringValue(rule.Id),
-				Duration:  types.StringValue(formatDuration(duration)),
+				Duration:  durationFromSeconds(int64(rule.VersionCooldown.IntervalSeconds)),
 			})
 		}
 		if rule.DeploymentWindow != nil {
@@ -1351,6 +1551,7 @@ func policyRulesToModel(rules []api.PolicyRule) (policyRulesModel, diag.Diagnost
 				Rrule:           types.StringValue(rule.DeploymentWindow.Rrule),
 				Timezone:        types.StringNull(),
 				AllowWindow:     types.BoolValue(rule.DeploymentWindow.AllowWindow),
+				LockToUTC:       types.BoolValue(false),
 			}
 			if rule.DeploymentWindow.Timezone != nil {
 				model.Timezone = types.StringValue(*rule.DeploymentWindow.Timezone)
@@ -1427,30 +1628,6 @@ func policyRulesToModel(rules []api.PolicyRule) (policyRulesModel, diag.Diagnost
 	return result, diags
 }
 
-func ensurePolicyIDs(plan *PolicyResourceModel, state *PolicyResourceModel) {
-	mergeVersionSelectorIDs(plan.VersionSelector, versionSelectorListFromState(state))
-	mergeCooldownIDs(plan.VersionCooldown, cooldownListFromState(state))
-	mergeWindowIDs(plan.DeploymentWindow, windowListFromState(state))
-	mergeDeploymentDependencyIDs(plan.DeploymentDependency, deploymentDependencyListFromState(state))
-	mergeVerificationIDs(plan.Verification, verificationListFromState(state))
-	mergeGradualRolloutIDs(plan.GradualRollout, gradualRolloutListFromState(state))
-	mergeAnyApprovalIDs(plan.AnyApproval, anyApprovalListFromState(state))
-	mergeEnvironmentProgressionIDs(plan.EnvironmentProgression, environmentProgressionListFromState(state))
-	mergePlanValidationOpaIDs(plan.PlanValidationOpa, planValidationOpaListFromState(state))
-}
-
-func ensurePolicyRuleCreatedAt(plan *PolicyResourceModel, state *PolicyResourceModel) {
-	mergeVersionSelectorCreatedAt(plan.VersionSelector, versionSelectorListFromState(state))
-	mergeCooldownCreatedAt(plan.VersionCooldown, cooldownListFromState(state))
-	mergeWindowCreatedAt(plan.DeploymentWindow, windowListFromState(state))
-	mergeDeploymentDependencyCreatedAt(plan.DeploymentDependency, deploymentDependencyListFromState(state))
-	mergeVerificationCreatedAt(plan.Verification, verificationListFromState(state))
-	mergeGradualRolloutCreatedAt(plan.GradualRollout, gradualRolloutListFromState(state))
-	mergeAnyApprovalCreatedAt(plan.AnyApproval, anyApprovalListFromState(state))
-	mergeEnvironmentProgressionCreatedAt(plan.EnvironmentProgression, environmentProgressionListFromState(state))
-	mergePlanValidationOpaCreatedAt(plan.PlanValidationOpa, planValidationOpaListFromState(state))
-}
-
 func setPolicyIDOnRules(request *policyRequestPayload, policyID string) {
 	if request == nil || request.Rules == nil {
 		return
@@ -1464,303 +1641,6 @@ func setPolicyIDOnRules(request *policyRequestPayload, policyID string) {
 	}
 }
 
-func versionSelectorListFromState(state *PolicyResourceModel) []PolicyVersionSelector {
-	if state == nil {
-		return nil
-	}
-	return state.VersionSelector
-}
-
-func mergeVersionSelectorIDs(plan []PolicyVersionSelector, state []PolicyVersionSelector) {
-	for i := range plan {
-		if selectorValueSet(plan[i].ID) {
-			continue
-		}
-		if i < len(state) && selectorValueSet(state[i].ID) {
-			plan[i].ID = state[i].ID
-			continue
-		}
-		plan[i].ID = types.StringValue(uuid.NewString())
-	}
-}
-
-func mergeVersionSelectorCreatedAt(plan []PolicyVersionSelector, state []PolicyVersionSelector) {
-	for i := range plan {
-		if selectorValueSet(plan[i].CreatedAt) {
-			continue
-		}
-		if i < len(state) && selectorValueSet(state[i].CreatedAt) {
-			plan[i].CreatedAt = state[i].CreatedAt
-			continue
-		}
-		plan[i].CreatedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
-	}
-}
-
-func cooldownListFromState(state *PolicyResourceModel) []PolicyVersionCooldown {
-	if state == nil {
-		return nil
-	}
-	return state.VersionCooldown
-}
-
-func windowListFromState(state *PolicyResourceModel) []PolicyDeploymentWindow {
-	if state == nil {
-		return nil
-	}
-	return state.DeploymentWindow
-}
-
-func verificationListFromState(state *PolicyResourceModel) []PolicyVerificationRule {
-	if state == nil {
-		return nil
-	}
-	return state.Verification
-}
-
-func deploymentDependencyListFromState(state *PolicyResourceModel) []PolicyDeploymentDependency {
-	if state == nil {
-		return nil
-	}
-	return state.DeploymentDependency
-}
-
-func mergeCooldownIDs(plan []PolicyVersionCooldown, state []PolicyVersionCooldown) {
-	for i := range plan {
-		if selectorValueSet(plan[i].ID) {
-			continue
-		}
-		if i < len(state) && selectorValueSet(state[i].ID) {
-			plan[i].ID = state[i].ID
-			continue
-		}
-		plan[i].ID = types.StringValue(uuid.NewString())
-	}
-}
-
-func mergeCooldownCreatedAt(plan []PolicyVersionCooldown, state []PolicyVersionCooldown) {
-	for i := range plan {
-		if selectorValueSet(plan[i].CreatedAt) {
-			continue
-		}
-		if i < len(state) && selectorValueSet(state[i].CreatedAt) {
-			plan[i].CreatedAt = state[i].CreatedAt
-			continue
-		}
-		plan[i].CreatedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
-	}
-}
-
-func mergeWindowIDs(plan []PolicyDeploymentWindow, state []PolicyDeploymentWindow) {
-	for i := range plan {
-		if selectorValueSet(plan[i].ID) {
-			continue
-		}
-		if i < len(state) && selectorValueSet(state[i].ID) {
-			plan[i].ID = state[i].ID
-			continue
-		}
-		plan[i].ID = types.StringValue(uuid.NewString())
-	}
-}
-
-func mergeWindowCreatedAt(plan []PolicyDeploymentWindow, state []PolicyDeploymentWindow) {
-	for i := range plan {
-		if selectorValueSet(plan[i].CreatedAt) {
-			continue
-		}
-		if i < len(state) && selectorValueSet(state[i].CreatedAt) {
-			plan[i].CreatedAt = state[i].CreatedAt
-			continue
-		}
-		plan[i].CreatedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
-	}
-}
-
-func mergeDeploymentDependencyIDs(plan []PolicyDeploymentDependency, state []PolicyDeploymentDependency) {
-	for i := range plan {
-		if selectorValueSet(plan[i].ID) {
-			continue
-		}
-		if i < len(state) && selectorValueSet(state[i].ID) {
-			plan[i].ID = state[i].ID
-			continue
-		}
-		plan[i].ID = types.StringValue(uuid.NewString())
-	}
-}
-
-func mergeDeploymentDependencyCreatedAt(plan []PolicyDeploymentDependency, state []PolicyDeploymentDependency) {
-	for i := range plan {
-		if selectorValueSet(plan[i].CreatedAt) {
-			continue
-		}
-		if i < len(state) && selectorValueSet(state[i].CreatedAt) {
-			plan[i].CreatedAt = state[i].CreatedAt
-			continue
-		}
-		plan[i].CreatedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
-	}
-}
-
-func mergeVerificationIDs(plan []PolicyVerificationRule, state []PolicyVerificationRule) {
-	for i := range plan {
-		if selectorValueSet(plan[i].ID) {
-			continue
-		}
-		if i < len(state) && selectorValueSet(state[i].ID) {
-			plan[i].ID = state[i].ID
-			continue
-		}
-		plan[i].ID = types.StringValue(uuid.NewString())
-	}
-}
-
-func mergeVerificationCreatedAt(plan []PolicyVerificationRule, state []PolicyVerificationRule) {
-	for i := range plan {
-		if selectorValueSet(plan[i].CreatedAt) {
-			continue
-		}
-		if i < len(state) && selectorValueSet(state[i].CreatedAt) {
-			plan[i].CreatedAt = state[i].CreatedAt
-			continue
-		}
-		plan[i].CreatedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
-	}
-}
-
-func gradualRolloutListFromState(state *PolicyResourceModel) []PolicyGradualRollout {
-	if state == nil {
-		return nil
-	}
-	return state.GradualRollout
-}
-
-func anyApprovalListFromState(state *PolicyResourceModel) []PolicyAnyApproval {
-	if state == nil {
-		return nil
-	}
-	return state.AnyApproval
-}
-
-func environmentProgressionListFromState(state *PolicyResourceModel) []PolicyEnvironmentProgression {
-	if state == nil {
-		return nil
-	}
-	return state.EnvironmentProgression
-}
-
-func mergeGradualRolloutIDs(plan []PolicyGradualRollout, state []PolicyGradualRollout) {
-	for i := range plan {
-		if selectorValueSet(plan[i].ID) {
-			continue
-		}
-		if i < len(state) && selectorValueSet(state[i].ID) {
-			plan[i].ID = state[i].ID
-			continue
-		}
-		plan[i].ID = types.StringValue(uuid.NewString())
-	}
-}
-
-func mergeGradualRolloutCreatedAt(plan []PolicyGradualRollout, state []PolicyGradualRollout) {
-	for i := range plan {
-		if selectorValueSet(plan[i].CreatedAt) {
-			continue
-		}
-		if i < len(state) && selectorValueSet(state[i].CreatedAt) {
-			plan[i].CreatedAt = state[i].CreatedAt
-			continue
-		}
-		plan[i].CreatedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
-	}
-}
-
-func mergeAnyApprovalIDs(plan []PolicyAnyApproval, state []PolicyAnyApproval) {
-	for i := range plan {
-		if selectorValueSet(plan[i].ID) {
-			continue
-		}
-		if i < len(state) && selectorValueSet(state[i].ID) {
-			plan[i].ID = state[i].ID
-			continue
-		}
-		plan[i].ID = types.StringValue(uuid.NewString())
-	}
-}
-
-func mergeAnyApprovalCreatedAt(plan []PolicyAnyApproval, state []PolicyAnyApproval) {
-	for i := range plan {
-		if selectorValueSet(plan[i].CreatedAt) {
-			continue
-		}
-		if i < len(state) && selectorValueSet(state[i].CreatedAt) {
-			plan[i].CreatedAt = state[i].CreatedAt
-			continue
-		}
-		plan[i].CreatedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
-	}
-}
-
-func mergeEnvironmentProgressionIDs(plan []PolicyEnvironmentProgression, state []PolicyEnvironmentProgression) {
-	for i := range plan {
-		if selectorValueSet(plan[i].ID) {
-			continue
-		}
-		if i < len(state) && selectorValueSet(state[i].ID) {
-			plan[i].ID = state[i].ID
-			continue
-		}
-		plan[i].ID = types.StringValue(uuid.NewString())
-	}
-}
-
-func mergeEnvironmentProgressionCreatedAt(plan []PolicyEnvironmentProgression, state []PolicyEnvironmentProgression) {
-	for i := range plan {
-		if selectorValueSet(plan[i].CreatedAt) {
-			continue
-		}
-		if i < len(state) && selectorValueSet(state[i].CreatedAt) {
-			plan[i].CreatedAt = state[i].CreatedAt
-			continue
-		}
-		plan[i].CreatedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
-	}
-}
-
-func planValidationOpaListFromState(state *PolicyResourceModel) []PolicyPlanValidationOpa {
-	if state == nil {
-		return nil
-	}
-	return state.PlanValidationOpa
-}
-
-func mergePlanValidationOpaIDs(plan []PolicyPlanValidationOpa, state []PolicyPlanValidationOpa) {
-	for i := range plan {
-		if selectorValueSet(plan[i].ID) {
-			continue
-		}
-		if i < len(state) && selectorValueSet(state[i].ID) {
-			plan[i].ID = state[i].ID
-			continue
-		}
-		plan[i].ID = types.StringValue(uuid.NewString())
-	}
-}
-
-func mergePlanValidationOpaCreatedAt(plan []PolicyPlanValidationOpa, state []PolicyPlanValidationOpa) {
-	for i := range plan {
-		if selectorValueSet(plan[i].CreatedAt) {
-			continue
-		}
-		if i < len(state) && selectorValueSet(state[i].CreatedAt) {
-			plan[i].CreatedAt = state[i].CreatedAt
-			continue
-		}
-		plan[i].CreatedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
-	}
-}
-
 func policyVerificationRuleToModel(rule *api.VerificationRule) (PolicyVerificationRule, error) {
 	model := PolicyVerificationRule{
 		TriggerOn: types.StringNull(),
@@ -1778,14 +1658,42 @@ func policyVerificationRuleToModel(rule *api.VerificationRule) (PolicyVerificati
 		}
 		model.Metric = append(model.Metric, m)
 	}
+	model.EstimatedDuration = verificationEstimatedDuration(model.Metric)
 
 	return model, nil
 }
 
+func fillVerificationEstimates(rules []PolicyVerificationRule) {
+	for i := range rules {
+		rules[i].EstimatedDuration = verificationEstimatedDuration(rules[i].Metric)
+	}
+}
+
+// verificationEstimatedDuration returns how long the slowest metric takes to
+// take all of its measurements. It is unknown while any interval or count is.
+func verificationEstimatedDuration(metrics []PolicyVerificationMetric) DurationValue {
+	if len(metrics) == 0 {
+		return NewDurationNull()
+	}
+
+	var longest int64
+	for _, metric := range metrics {
+		if metric.Interval.IsUnknown() || metric.Count.IsUnknown() {
+			return NewDurationUnknown()
+		}
+		interval, err := parseDurationSeconds(metric.Interval)
+		if err != nil || metric.Count.IsNull() {
+			continue
+		}
+		longest = max(longest, interval*metric.Count.ValueInt64())
+	}
+	return durationFromSeconds(longest)
+}
+
 func policyVerificationMetricToModel(metric api.VerificationMetricSpec) (PolicyVerificationMetric, error) {
 	model := PolicyVerificationMetric{
 		Name:     types.StringValue(metric.Name),
-		Interval: types.StringValue((time.Duration(metric.IntervalSeconds) * time.Second).String()),
+		Interval: durationFromSeconds(int64(metric.IntervalSeconds)),
 		Count:    types.Int64Value(int64(metric.Count)),
 		Success: &PolicyVerificationCondition{
 			Condition: types.StringValue(metric.SuccessCondition),
@@ -1833,6 +1741,20 @@ func policyVerificationMetricToModel(metric api.VerificationMetricSpec) (PolicyV
 			DurationSeconds: types.Int64Value(int64(sleepProvider.DurationSeconds)),
 		}
 		return model, nil
+	case "http":
+		httpProvider, err := metric.Provider.AsHTTPMetricProvider()
+		if err != nil {
+			return PolicyVerificationMetric{}, fmt.Errorf("failed to parse http provider: %w", err)
+		}
+		model.HTTP = policyHTTPProviderToModel(httpProvider)
+		return model, nil
+	case "prometheus":
+		prometheusProvider, err := metric.Provider.AsPrometheusMetricProvider()
+		if err != nil {
+			return PolicyVerificationMetric{}, fmt.Errorf("failed to parse prometheus provider: %w", err)
+		}
+		model.Prometheus = policyPrometheusProviderToModel(prometheusProvider)
+		return model, nil
 	case "datadog":
 	default:
 		return PolicyVerificationMetric{}, fmt.Errorf("unsupported metric provider type: %q", discriminator.Type)
@@ -1848,9 +1770,9 @@ func policyVerificationMetricToModel(metric api.VerificationMetricSpec) (PolicyV
 	if datadogProvider.Site != nil {
 		model.Datadog.Site = types.StringValue(*datadogProvider.Site)
 	}
-	model.Datadog.Interval = types.StringNull()
+	model.Datadog.Interval = NewDurationNull()
 	if datadogProvider.IntervalSeconds != nil {
-		model.Datadog.Interval = types.StringValue((time.Duration(*datadogProvider.IntervalSeconds) * time.Second).String())
+		model.Datadog.Interval = durationFromSeconds(int64(*datadogProvider.IntervalSeconds))
 	}
 	model.Datadog.Queries = types.MapNull(types.StringType)
 	if len(datadogProvider.Queries) > 0 {
@@ -1871,22 +1793,51 @@ func policyVerificationMetricToModel(metric api.VerificationMetricSpec) (PolicyV
 	return model, nil
 }
 
-func parseDurationSeconds(value types.String) (int64, error) {
-	if value.IsNull() || value.IsUnknown() {
-		return 0, fmt.Errorf("duration must be set")
+func policyHTTPProviderToModel(provider api.HTTPMetricProvider) *PolicyHTTPProvider {
+	model := &PolicyHTTPProvider{
+		URL:     types.StringValue(provider.Url),
+		Method:  types.StringNull(),
+		Headers: types.MapNull(types.StringType),
+		Body:    types.StringNull(),
+		Timeout: types.StringNull(),
 	}
-	raw := value.ValueString()
-	duration, err := time.ParseDuration(raw)
-	if err != nil {
-		return 0, fmt.Errorf("invalid duration %q", raw)
+	if provider.Method != nil {
+		model.Method = types.StringValue(string(*provider.Method))
+	}
+	if provider.Headers != nil && len(*provider.Headers) > 0 {
+		model.Headers, _ = types.MapValueFrom(context.Background(), types.StringType, *provider.Headers)
+	}
+	if provider.Body != nil {
+		model.Body = types.StringValue(*provider.Body)
+	}
+	if provider.Timeout != nil {
+		model.Timeout = types.StringValue(*provider.Timeout)
 	}
-	if duration < 0 {
-		return 0, fmt.Errorf("duration must be non-negative")
+	return model
+}
+
+func policyPrometheusProviderToModel(provider api.PrometheusMetricProvider) *PolicyPrometheusProvider {
+	model := &PolicyPrometheusProvider{
+		Address:     types.StringValue(provider.Address),
+		Query:       types.StringValue(provider.Query),
+		BearerToken: types.StringNull(),
+		Headers:     types.MapNull(types.StringType),
+		Step:        types.StringNull(),
+	}
+	if provider.Authentication != nil && provider.Authentication.BearerToken != nil {
+		model.BearerToken = types.StringValue(*provider.Authentication.BearerToken)
+	}
+	if provider.Headers != nil && len(*provider.Headers) > 0 {
+		headers := make(map[string]string, len(*provider.Headers))
+		for _, header := range *provider.Headers {
+			headers[header.Key] = header.Value
+		}
+		model.Headers, _ = types.MapValueFrom(context.Background(), types.StringType, headers)
 	}
-	if duration%time.Second != 0 {
-		return 0, fmt.Errorf("duration %q must be a whole number of seconds", raw)
+	if provider.RangeQuery != nil {
+		model.Step = types.StringValue(provider.RangeQuery.Step)
 	}
-	return int64(duration.Seconds()), nil
+	return model
 }
 
 func mapStringValue(value types.Map) (map[string]string, error) {
@@ -4,11 +4,14 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
@@ -31,6 +34,11 @@ func TestAccPolicyResource(t *testing.T) {
 						tfjsonpath.New("id"),
 						knownvalue.NotNull(),
 					),
+					statecheck.ExpectKnownValue(
+						"ctrlplane_policy.test",
+						tfjsonpath.New("spec_json"),
+						knownvalue.NotNull(),
+					),
 					statecheck.ExpectKnownValue(
 						"ctrlplane_policy.test",
 						tfjsonpath.New("name"),
@@ -93,6 +101,50 @@ func TestAccPolicyResource(t *testing.T) {
 					),
 				},
 			},
+			// Import by name, with and without the workspace prefix
+			{
+				ResourceName:      "ctrlplane_policy.test",
+				ImportState:       true,
+				ImportStateId:     updatedName,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "ctrlplane_policy.test",
+				ImportState:       true,
+				ImportStateId:     testAccWorkspaceImportID(updatedName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccPolicyResource_importAmbiguousName(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-policy-dup-%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+resource "ctrlplane_policy" "first" {
+  name     = %q
+  selector = "true"
+}
+
+resource "ctrlplane_policy" "second" {
+  name     = %q
+  selector = "true"
+}
+`, testAccProviderConfig(), name, name),
+			},
+			{
+				ResourceName:  "ctrlplane_policy.first",
+				ImportState:   true,
+				ImportStateId: name,
+				ExpectError:   regexp.MustCompile(`2 policies named`),
+			},
 		},
 	})
 }
@@ -138,6 +190,358 @@ func TestAccPolicyResourceSleepVerification(t *testing.T) {
 	})
 }
 
+func TestAccPolicyResourcePrometheusVerification(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-policy-prometheus-%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+resource "ctrlplane_policy" "test_prometheus" {
+  name     = %q
+  selector = "true"
+
+  verification {
+    metric {
+      name     = "error-rate"
+      interval = "30s"
+      count    = 3
+
+      success {
+        condition = "result.value < 0.01"
+      }
+
+      prometheus {
+        address      = "http://prometheus:9090"
+        query        = "sum(rate(http_requests_total{code=~\"5..\"}[5m]))"
+        bearer_token = "dummy"
+        step         = "30s"
+        headers = {
+          X-Scope-OrgID = "tenant-1"
+        }
+      }
+    }
+  }
+}
+`, testAccProviderConfig(), name),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"ctrlplane_policy.test_prometheus",
+						tfjsonpath.New("verification").AtSliceIndex(0).AtMapKey("metric").AtSliceIndex(0).AtMapKey("prometheus").AtMapKey("address"),
+						knownvalue.StringExact("http://prometheus:9090"),
+					),
+					statecheck.ExpectKnownValue(
+						"ctrlplane_policy.test_prometheus",
+						tfjsonpath.New("verification").AtSliceIndex(0).AtMapKey("metric").AtSliceIndex(0).AtMapKey("prometheus").AtMapKey("step"),
+						knownvalue.StringExact("30s"),
+					),
+					statecheck.ExpectKnownValue(
+						"ctrlplane_policy.test_prometheus",
+						tfjsonpath.New("verification").AtSliceIndex(0).AtMapKey("estimated_duration"),
+						knownvalue.StringExact("90s"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccPolicyResourceHTTPVerification(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-policy-http-%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+resource "ctrlplane_policy" "test_http" {
+  name     = %q
+  selector = "true"
+
+  verification {
+    metric {
+      name     = "health"
+      interval = "30s"
+      count    = 3
+
+      success {
+        condition = "result.statusCode == 200 && result.json.status == 'ok'"
+      }
+
+      http {
+        url     = "https://{{.resource.name}}.example.com/health"
+        method  = "POST"
+        body    = "{\"check\": \"deep\"}"
+        timeout = "10s"
+        headers = {
+          Authorization = "Bearer dummy"
+        }
+      }
+    }
+  }
+}
+`, testAccProviderConfig(), name),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"ctrlplane_policy.test_http",
+						tfjsonpath.New("verification").AtSliceIndex(0).AtMapKey("metric").AtSliceIndex(0).AtMapKey("http").AtMapKey("method"),
+						knownvalue.StringExact("POST"),
+					),
+					statecheck.ExpectKnownValue(
+						"ctrlplane_policy.test_http",
+						tfjsonpath.New("verification").AtSliceIndex(0).AtMapKey("metric").AtSliceIndex(0).AtMapKey("http").AtMapKey("timeout"),
+						knownvalue.StringExact("10s"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccPolicyResourceDurationNormalization(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-policy-duration-%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+resource "ctrlplane_policy" "test_duration" {
+  name     = %q
+  selector = "true"
+
+  version_cooldown {
+    duration = "90m"
+  }
+
+  verification {
+    metric {
+      name     = "sleep"
+      interval = "60s"
+      count    = 1
+
+      success {
+        condition = "true"
+      }
+
+      sleep {
+        duration_seconds = 1
+      }
+    }
+  }
+}
+`, testAccProviderConfig(), name),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"ctrlplane_policy.test_duration",
+						tfjsonpath.New("version_cooldown").AtSliceIndex(0).AtMapKey("duration"),
+						knownvalue.StringExact("90m"),
+					),
+					statecheck.ExpectKnownValue(
+						"ctrlplane_policy.test_duration",
+						tfjsonpath.New("verification").AtSliceIndex(0).AtMapKey("metric").AtSliceIndex(0).AtMapKey("interval"),
+						knownvalue.StringExact("60s"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccPolicyResourceRuleOrder(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-policy-order-%d", time.Now().UnixNano())
+	rcSelectorID := statecheck.CompareValue(compare.ValuesSame())
+	approvedSelectorID := statecheck.CompareValue(compare.ValuesSame())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyResourceRuleOrderConfig(name, false),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"ctrlplane_policy.test_order",
+						tfjsonpath.New("version_selector").AtSliceIndex(0).AtMapKey("selector"),
+						knownvalue.StringExact("!version.tag.contains('-rc')"),
+					),
+					rcSelectorID.AddStateValue(
+						"ctrlplane_policy.test_order",
+						tfjsonpath.New("version_selector").AtSliceIndex(0).AtMapKey("id"),
+					),
+					approvedSelectorID.AddStateValue(
+						"ctrlplane_policy.test_order",
+						tfjsonpath.New("version_selector").AtSliceIndex(1).AtMapKey("id"),
+					),
+				},
+			},
+			{
+				// Reordering blocks moves rules within the list; each rule
+				// keeps its ID rather than taking over its neighbour's.
+				Config: testAccPolicyResourceRuleOrderConfig(name, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("ctrlplane_policy.test_order", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(
+							"ctrlplane_policy.test_order",
+							tfjsonpath.New("version_selector").AtSliceIndex(0).AtMapKey("id"),
+							knownvalue.NotNull(),
+						),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"ctrlplane_policy.test_order",
+						tfjsonpath.New("version_selector").AtSliceIndex(0).AtMapKey("selector"),
+						knownvalue.StringExact("version.metadata['approved'] == 'true'"),
+					),
+					rcSelectorID.AddStateValue(
+						"ctrlplane_policy.test_order",
+						tfjsonpath.New("version_selector").AtSliceIndex(1).AtMapKey("id"),
+					),
+					approvedSelectorID.AddStateValue(
+						"ctrlplane_policy.test_order",
+						tfjsonpath.New("version_selector").AtSliceIndex(0).AtMapKey("id"),
+					),
+				},
+			},
+			{
+				Config: testAccPolicyResourceRuleOrderConfig(name, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccPolicyResourceConfigValidators(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-policy-invalid-%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+resource "ctrlplane_policy" "test_invalid" {
+  name     = %q
+  selector = "true"
+
+  gradual_rollout {
+    rollout_type        = "exponential"
+    time_scale_interval = 60
+  }
+}
+`, testAccProviderConfig(), name),
+				ExpectError: regexp.MustCompile(`rollout_type must be "linear" or "linear-normalized"`),
+			},
+			{
+				Config: fmt.Sprintf(`
+%s
+resource "ctrlplane_policy" "test_invalid" {
+  name     = %q
+  selector = "true"
+
+  verification {
+    metric {
+      name     = "no-provider"
+      interval = "30s"
+      count    = 1
+
+      success {
+        condition = "result.ok == true"
+      }
+    }
+  }
+}
+`, testAccProviderConfig(), name),
+				ExpectError: regexp.MustCompile(`Exactly one of sleep, datadog, prometheus or http provider block is required`),
+			},
+			{
+				Config: fmt.Sprintf(`
+%s
+resource "ctrlplane_policy" "test_invalid" {
+  name     = %q
+  selector = "true"
+
+  version_cooldown {
+    duration = "1.5s"
+  }
+}
+`, testAccProviderConfig(), name),
+				ExpectError: regexp.MustCompile(`must be a whole number of seconds`),
+			},
+			{
+				Config: fmt.Sprintf(`
+%s
+resource "ctrlplane_policy" "test_invalid" {
+  name     = %q
+  selector = "deployment.name == "
+}
+`, testAccProviderConfig(), name),
+				ExpectError: regexp.MustCompile(`Invalid CEL expression`),
+			},
+			{
+				Config: fmt.Sprintf(`
+%s
+resource "ctrlplane_policy" "test_invalid" {
+  name     = %q
+  selector = "true"
+
+  deployment_window {
+    duration_minutes = 60
+    rrule            = "FREQ=WEEKLY;BYDAY=1MO"
+  }
+}
+`, testAccProviderConfig(), name),
+				ExpectError: regexp.MustCompile(`BYDAY ordinals such as "1MO" can only be used with FREQ=MONTHLY`),
+			},
+		},
+	})
+}
+
+func testAccPolicyResourceRuleOrderConfig(name string, reversed bool) string {
+	rules := []string{`
+  version_selector {
+    selector = "!version.tag.contains('-rc')"
+  }
+
+  deployment_dependency {
+    depends_on_selector = "deployment.name == 'database'"
+  }
+`, `
+  version_selector {
+    selector = "version.metadata['approved'] == 'true'"
+  }
+
+  deployment_dependency {
+    depends_on_selector = "deployment.name == 'cache'"
+  }
+`}
+	if reversed {
+		rules[0], rules[1] = rules[1], rules[0]
+	}
+
+	return fmt.Sprintf(`
+%s
+resource "ctrlplane_policy" "test_order" {
+  name     = %q
+  selector = "true"
+%s%s}
+`, testAccProviderConfig(), name, rules[0], rules[1])
+}
+
 func testAccPolicyResourceSleepVerificationConfig(name string, durationSeconds int) string {
 	return fmt.Sprintf(`
 %s
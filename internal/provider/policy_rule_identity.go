@@ -0,0 +1,286 @@
+// Copyright IBM Corp. 2021, 2026
+
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Policy rule blocks are lists, but a rule is identified by its content rather
+// than its position. Rules keep their server-side IDs across plans as long as
+// their configuration is unchanged, so reordering blocks only moves rules
+// within the list and never reassigns their IDs.
+
+// matchPolicyRulesToState copies id and created_at from prior state into each
+// planned rule whose configuration is identical to a prior rule, wherever it
+// sits in the list. Planned rules that match nothing take over the identity of
+// a prior rule that is no longer configured, so editing a rule updates it in
+// place. Only rules left over after that are planned with a new ID.
+func matchPolicyRulesToState(plan tftypes.Value, state tftypes.Value) (tftypes.Value, error) {
+	if state.IsNull() || !plan.IsKnown() {
+		return plan, nil
+	}
+
+	var planAttrs, stateAttrs map[string]tftypes.Value
+	if err := plan.As(&planAttrs); err != nil {
+		return plan, err
+	}
+	if err := state.As(&stateAttrs); err != nil {
+		return plan, err
+	}
+
+	for _, block := range policyRuleBlocks {
+		matched, err := matchPolicyRuleList(planAttrs[block], stateAttrs[block])
+		if err != nil {
+			return plan, fmt.Errorf("%s: %w", block, err)
+		}
+		planAttrs[block] = matched
+	}
+
+	return tftypes.NewValue(plan.Type(), planAttrs), nil
+}
+
+func matchPolicyRuleList(plan tftypes.Value, state tftypes.Value) (tftypes.Value, error) {
+	if plan.IsNull() || !plan.IsKnown() || state.IsNull() || !state.IsKnown() {
+		return plan, nil
+	}
+
+	var planRules, stateRules []tftypes.Value
+	if err := plan.As(&planRules); err != nil {
+		return plan, err
+	}
+	if err := state.As(&stateRules); err != nil {
+		return plan, err
+	}
+
+	planAttrs := make([]map[string]tftypes.Value, len(planRules))
+	for i, rule := range planRules {
+		if rule.IsNull() || !rule.IsKnown() {
+			continue
+		}
+		if err := rule.As(&planAttrs[i]); err != nil {
+			return plan, err
+		}
+	}
+
+	stateAttrs := make([]map[string]tftypes.Value, len(stateRules))
+	claimed := make([]bool, len(stateRules))
+	for j, prior := range stateRules {
+		if prior.IsNull() || !prior.IsKnown() {
+			claimed[j] = true
+			continue
+		}
+		if err := prior.As(&stateAttrs[j]); err != nil {
+			return plan, err
+		}
+		// A configured id claims its prior rule even if the rest changed.
+		for _, attrs := range planAttrs {
+			if attrs != nil && attrs["id"].IsKnown() && attrs["id"].Equal(stateAttrs[j]["id"]) {
+				claimed[j] = true
+			}
+		}
+	}
+
+	claim := func(attrs map[string]tftypes.Value, sameContent bool) {
+		if attrs == nil || attrs["id"].IsKnown() {
+			return
+		}
+		for j := range stateAttrs {
+			if claimed[j] || (sameContent && !policyRuleContentEqual(attrs, stateAttrs[j])) {
+				continue
+			}
+			claimed[j] = true
+			attrs["id"] = stateAttrs[j]["id"]
+			attrs["created_at"] = stateAttrs[j]["created_at"]
+			return
+		}
+	}
+	for _, attrs := range planAttrs {
+		claim(attrs, true)
+	}
+	for _, attrs := range planAttrs {
+		claim(attrs, false)
+	}
+
+	for i, attrs := range planAttrs {
+		if attrs != nil {
+			planRules[i] = tftypes.NewValue(planRules[i].Type(), attrs)
+		}
+	}
+	return tftypes.NewValue(plan.Type(), planRules), nil
+}
+
+// policyRuleContentEqual reports whether a planned rule describes the same
+// rule as a prior one. An unknown id or created_at matches anything; a
+// configured id (version_selector allows one) must be equal.
+func policyRuleContentEqual(plan map[string]tftypes.Value, prior map[string]tftypes.Value) bool {
+	for name, value := range plan {
+		if (name == "id" || name == "created_at") && !value.IsKnown() {
+			continue
+		}
+		if !value.Equal(prior[name]) {
+			return false
+		}
+	}
+	return true
+}
+
+// ensurePolicyRuleIdentities assigns an ID and creation timestamp to every
+// planned rule that lacks one. Unmatched rules take over the IDs of prior
+// rules that are no longer configured, so editing a rule updates it in place;
+// any rule left over gets a new ID.
+func ensurePolicyRuleIdentities(plan *PolicyResourceModel, state *PolicyResourceModel) {
+	if state == nil {
+		state = &PolicyResourceModel{}
+	}
+
+	mergeRuleIdentities(plan.VersionSelector, state.VersionSelector, (*PolicyVersionSelector).identity)
+	mergeRuleIdentities(plan.VersionCooldown, state.VersionCooldown, (*PolicyVersionCooldown).identity)
+	mergeRuleIdentities(plan.DeploymentWindow, state.DeploymentWindow, (*PolicyDeploymentWindow).identity)
+	mergeRuleIdentities(plan.DeploymentDependency, state.DeploymentDependency, (*PolicyDeploymentDependency).identity)
+	mergeRuleIdentities(plan.Verification, state.Verification, (*PolicyVerificationRule).identity)
+	mergeRuleIdentities(plan.GradualRollout, state.GradualRollout, (*PolicyGradualRollout).identity)
+	mergeRuleIdentities(plan.AnyApproval, state.AnyApproval, (*PolicyAnyApproval).identity)
+	mergeRuleIdentities(plan.EnvironmentProgression, state.EnvironmentProgression, (*PolicyEnvironmentProgression).identity)
+	mergeRuleIdentities(plan.PlanValidationOpa, state.PlanValidationOpa, (*PolicyPlanValidationOpa).identity)
+}
+
+func mergeRuleIdentities[T any](plan []T, state []T, identity func(*T) (*types.String, *types.String)) {
+	used := map[string]bool{}
+	for i := range plan {
+		if id, _ := identity(&plan[i]); selectorValueSet(*id) {
+			used[id.ValueString()] = true
+		}
+	}
+
+	createdAt := map[string]types.String{}
+	var unused []types.String
+	for i := range state {
+		id, created := identity(&state[i])
+		if !selectorValueSet(*id) {
+			continue
+		}
+		createdAt[id.ValueString()] = *created
+		if !used[id.ValueString()] {
+			unused = append(unused, *id)
+		}
+	}
+
+	for i := range plan {
+		id, created := identity(&plan[i])
+		if !selectorValueSet(*id) {
+			if len(unused) > 0 {
+				*id = unused[0]
+				unused = unused[1:]
+			} else {
+				*id = types.StringValue(uuid.NewString())
+			}
+		}
+
+		if selectorValueSet(*created) {
+			continue
+		}
+		if prior, ok := createdAt[id.ValueString()]; ok && selectorValueSet(prior) {
+			*created = prior
+			continue
+		}
+		*created = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	}
+}
+
+// orderPolicyRules sorts rules read from the API into the order of the prior
+// rules with the same IDs, so the order of blocks in configuration survives a
+// refresh. Rules the prior model does not know follow in API order.
+func orderPolicyRules(rules *policyRulesModel, prior PolicyResourceModel) {
+	orderRulesLike(rules.VersionSelector, prior.VersionSelector, (*PolicyVersionSelector).identity)
+	orderRulesLike(rules.VersionCooldown, prior.VersionCooldown, (*PolicyVersionCooldown).identity)
+	orderRulesLike(rules.DeploymentWindow, prior.DeploymentWindow, (*PolicyDeploymentWindow).identity)
+	orderRulesLike(rules.DeploymentDependency, prior.DeploymentDependency, (*PolicyDeploymentDependency).identity)
+	orderRulesLike(rules.Verification, prior.Verification, (*PolicyVerificationRule).identity)
+	orderRulesLike(rules.GradualRollout, prior.GradualRollout, (*PolicyGradualRollout).identity)
+	orderRulesLike(rules.AnyApproval, prior.AnyApproval, (*PolicyAnyApproval).identity)
+	orderRulesLike(rules.EnvironmentProgression, prior.EnvironmentProgression, (*PolicyEnvironmentProgression).identity)
+	orderRulesLike(rules.PlanValidationOpa, prior.PlanValidationOpa, (*PolicyPlanValidationOpa).identity)
+}
+
+func orderRulesLike[T any](read []T, prior []T, identity func(*T) (*types.String, *types.String)) {
+	position := map[string]int{}
+	for i := range prior {
+		if id, _ := identity(&prior[i]); selectorValueSet(*id) {
+			position[id.ValueString()] = i
+		}
+	}
+
+	rank := func(rule *T) int {
+		id, _ := identity(rule)
+		if i, ok := position[id.ValueString()]; ok {
+			return i
+		}
+		return len(prior)
+	}
+	sort.SliceStable(read, func(a, b int) bool { return rank(&read[a]) < rank(&read[b]) })
+}
+
+// mergeWindowLockToUTC carries lock_to_utc over from prior state, since the API
+// only stores the resulting "UTC" timezone. Locked windows report a null
+// timezone so they match configuration.
+func mergeWindowLockToUTC(read []PolicyDeploymentWindow, prior []PolicyDeploymentWindow) {
+	locked := map[string]bool{}
+	for _, window := range prior {
+		if selectorValueSet(window.ID) && defaultBool(window.LockToUTC, false) {
+			locked[window.ID.ValueString()] = true
+		}
+	}
+
+	for i := range read {
+		if !locked[read[i].ID.ValueString()] {
+			continue
+		}
+		read[i].LockToUTC = types.BoolValue(true)
+		if read[i].Timezone.ValueString() == "UTC" {
+			read[i].Timezone = types.StringNull()
+		}
+	}
+}
+
+func (r *PolicyVersionSelector) identity() (*types.String, *types.String) {
+	return &r.ID, &r.CreatedAt
+}
+
+func (r *PolicyVersionCooldown) identity() (*types.String, *types.String) {
+	return &r.ID, &r.CreatedAt
+}
+
+func (r *PolicyDeploymentWindow) identity() (*types.String, *types.String) {
+	return &r.ID, &r.CreatedAt
+}
+
+func (r *PolicyDeploymentDependency) identity() (*types.String, *types.String) {
+	return &r.ID, &r.CreatedAt
+}
+
+func (r *PolicyVerificationRule) identity() (*types.String, *types.String) {
+	return &r.ID, &r.CreatedAt
+}
+
+func (r *PolicyGradualRollout) identity() (*types.String, *types.String) {
+	return &r.ID, &r.CreatedAt
+}
+
+func (r *PolicyAnyApproval) identity() (*types.String, *types.String) {
+	return &r.ID, &r.CreatedAt
+}
+
+func (r *PolicyEnvironmentProgression) identity() (*types.String, *types.String) {
+	return &r.ID, &r.CreatedAt
+}
+
+func (r *PolicyPlanValidationOpa) identity() (*types.String, *types.String) {
+	return &r.ID, &r.CreatedAt
+}
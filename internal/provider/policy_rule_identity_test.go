@@ -0,0 +1,117 @@
+// Copyright IBM Corp. 2021, 2026
+
+package provider
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var testRuleType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+	"id":         tftypes.String,
+	"created_at": tftypes.String,
+	"selector":   tftypes.String,
+}}
+
+func testRuleList(rules ...tftypes.Value) tftypes.Value {
+	return tftypes.NewValue(tftypes.List{ElementType: testRuleType}, rules)
+}
+
+func testRule(id, createdAt interface{}, selector string) tftypes.Value {
+	return tftypes.NewValue(testRuleType, map[string]tftypes.Value{
+		"id":         tftypes.NewValue(tftypes.String, id),
+		"created_at": tftypes.NewValue(tftypes.String, createdAt),
+		"selector":   tftypes.NewValue(tftypes.String, selector),
+	})
+}
+
+func TestMatchPolicyRuleList(t *testing.T) {
+	unknown := tftypes.UnknownValue
+	state := testRuleList(
+		testRule("a", "t1", "first"),
+		testRule("b", "t2", "second"),
+	)
+
+	cases := map[string]struct {
+		plan tftypes.Value
+		want []string
+	}{
+		"unchanged": {
+			plan: testRuleList(testRule(unknown, unknown, "first"), testRule(unknown, unknown, "second")),
+			want: []string{"a", "b"},
+		},
+		"reordered": {
+			plan: testRuleList(testRule(unknown, unknown, "second"), testRule(unknown, unknown, "first")),
+			want: []string{"b", "a"},
+		},
+		"edited rule keeps its id": {
+			plan: testRuleList(testRule(unknown, unknown, "second"), testRule(unknown, unknown, "edited")),
+			want: []string{"b", "a"},
+		},
+		"added rule": {
+			plan: testRuleList(testRule(unknown, unknown, "first"), testRule(unknown, unknown, "second"), testRule(unknown, unknown, "third")),
+			want: []string{"a", "b", ""},
+		},
+		"configured id claims its rule": {
+			plan: testRuleList(testRule("b", unknown, "first"), testRule(unknown, unknown, "third")),
+			want: []string{"b", "a"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			matched, err := matchPolicyRuleList(tc.plan, state)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var rules []tftypes.Value
+			if err := matched.As(&rules); err != nil {
+				t.Fatal(err)
+			}
+			if len(rules) != len(tc.want) {
+				t.Fatalf("got %d rules, want %d", len(rules), len(tc.want))
+			}
+			for i, rule := range rules {
+				var attrs map[string]tftypes.Value
+				if err := rule.As(&attrs); err != nil {
+					t.Fatal(err)
+				}
+				if !attrs["id"].IsKnown() {
+					if tc.want[i] != "" {
+						t.Errorf("rule %d: got unknown id, want %q", i, tc.want[i])
+					}
+					continue
+				}
+				var id string
+				if err := attrs["id"].As(&id); err != nil {
+					t.Fatal(err)
+				}
+				if id != tc.want[i] {
+					t.Errorf("rule %d: got id %q, want %q", i, id, tc.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestOrderRulesLike(t *testing.T) {
+	rule := func(id string) PolicyVersionCooldown {
+		return PolicyVersionCooldown{ID: types.StringValue(id)}
+	}
+	read := []PolicyVersionCooldown{rule("new"), rule("a"), rule("b")}
+	prior := []PolicyVersionCooldown{rule("b"), rule("a")}
+
+	orderRulesLike(read, prior, (*PolicyVersionCooldown).identity)
+
+	var got []string
+	for _, r := range read {
+		got = append(got, r.ID.ValueString())
+	}
+	if want := []string{"b", "a", "new"}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
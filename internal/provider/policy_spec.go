@@ -0,0 +1,254 @@
+// Copyright IBM Corp. 2021, 2026
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ resource.ResourceWithModifyPlan = &PolicyResource{}
+
+// policyRuleBlocks are the policy attributes grouped under "rules" in
+// spec_json, keyed by the same names as the resource blocks.
+var policyRuleBlocks = []string{
+	"version_selector",
+	"version_cooldown",
+	"deployment_window",
+	"deployment_dependency",
+	"verification",
+	"gradual_rollout",
+	"any_approval",
+	"environment_progression",
+	"plan_validation_opa",
+}
+
+// policySpecOmitted are server-assigned or derived attributes that would make
+// spec_json churn without describing the policy itself.
+var policySpecOmitted = map[string]bool{
+	"id":         true,
+	"created_at": true,
+	"app_url":    true,
+	"entity_url": true,
+	"spec_json":  true,
+	"timeouts":   true,
+
+	"estimated_duration": true,
+}
+
+var errPolicySpecUnknown = errors.New("policy spec depends on unknown values")
+
+// ModifyPlan keeps the IDs of rules whose configuration is unchanged and
+// computes spec_json from the planned policy so it can be inspected in plan
+// output before apply.
+func (r *PolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	defer recordPlannedChange(ctx, r.workspace, "ctrlplane_policy", req, resp)
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(planUnsetMetadata(ctx, r.workspace, req, &resp.Plan)...)
+	resp.Diagnostics.Append(planVerificationEstimates(ctx, &resp.Plan)...)
+	resp.Diagnostics.Append(planPolicySystemSelector(ctx, r.workspace, &resp.Plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	matched, err := matchPolicyRulesToState(req.Plan.Raw, req.State.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to match policy rules to state", err.Error())
+		return
+	}
+	resp.Plan.Raw = matched
+
+	spec, err := policySpecJSON(resp.Plan.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to build policy spec", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("spec_json"), spec)...)
+}
+
+// planVerificationEstimates fills in estimated_duration of each planned
+// verification rule. This runs before rules are matched to state, so a rule
+// whose metrics are unchanged matches its prior estimate.
+func planVerificationEstimates(ctx context.Context, plan *tfsdk.Plan) diag.Diagnostics {
+	var list types.List
+	diags := plan.GetAttribute(ctx, path.Root("verification"), &list)
+	if diags.HasError() || list.IsNull() || list.IsUnknown() {
+		return diags
+	}
+
+	// Elements or metric blocks that are still unknown cannot be decoded;
+	// their estimates stay unknown until apply.
+	var rules []PolicyVerificationRule
+	if list.ElementsAs(ctx, &rules, false).HasError() {
+		return diags
+	}
+	fillVerificationEstimates(rules)
+
+	diags.Append(plan.SetAttribute(ctx, path.Root("verification"), rules)...)
+	return diags
+}
+
+// setPolicySpecJSON recomputes spec_json from the values already in state.
+func setPolicySpecJSON(ctx context.Context, state *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+	spec, err := policySpecJSON(state.Raw)
+	if err != nil {
+		diags.AddError("Failed to build policy spec", err.Error())
+		return diags
+	}
+	diags.Append(state.SetAttribute(ctx, path.Root("spec_json"), spec)...)
+	return diags
+}
+
+// policySpecJSON renders a policy as stable JSON: rule blocks are grouped under
+// "rules", IDs and timestamps are dropped, null and empty values are omitted,
+// and credentials are replaced with "(sensitive)". It returns an unknown value
+// while any included attribute is unknown.
+func policySpecJSON(policy tftypes.Value) (types.String, error) {
+	decoded, err := policySpecValue(policy)
+	if errors.Is(err, errPolicySpecUnknown) {
+		return types.StringUnknown(), nil
+	}
+	if err != nil {
+		return types.StringNull(), err
+	}
+
+	spec, ok := decoded.(map[string]interface{})
+	if !ok {
+		return types.StringNull(), errors.New("policy is not an object")
+	}
+
+	rules := make(map[string]interface{})
+	for _, block := range policyRuleBlocks {
+		if value, ok := spec[block]; ok {
+			rules[block] = value
+			delete(spec, block)
+		}
+	}
+	spec["rules"] = rules
+
+	encoded, err := json.Marshal(spec)
+	if err != nil {
+		return types.StringNull(), err
+	}
+	return types.StringValue(string(encoded)), nil
+}
+
+func policySpecValue(value tftypes.Value) (interface{}, error) {
+	if !value.IsKnown() {
+		return nil, errPolicySpecUnknown
+	}
+	if value.IsNull() {
+		return nil, nil
+	}
+
+	switch value.Type().(type) {
+	case tftypes.Object:
+		var attributes map[string]tftypes.Value
+		if err := value.As(&attributes); err != nil {
+			return nil, err
+		}
+		result := make(map[string]interface{}, len(attributes))
+		for key, raw := range attributes {
+			if policySpecOmitted[key] {
+				continue
+			}
+			if isSensitivePolicyKey(key) {
+				if !raw.IsNull() {
+					result[key] = "(sensitive)"
+				}
+				continue
+			}
+			converted, err := policySpecValue(raw)
+			if err != nil {
+				return nil, err
+			}
+			if converted == nil {
+				continue
+			}
+			if list, ok := converted.([]interface{}); ok && len(list) == 0 {
+				continue
+			}
+			result[key] = converted
+		}
+		return result, nil
+	case tftypes.Map:
+		var elements map[string]tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return nil, err
+		}
+		result := make(map[string]interface{}, len(elements))
+		for key, raw := range elements {
+			converted, err := policySpecValue(raw)
+			if err != nil {
+				return nil, err
+			}
+			result[key] = converted
+		}
+		return result, nil
+	case tftypes.List, tftypes.Set, tftypes.Tuple:
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return nil, err
+		}
+		result := make([]interface{}, 0, len(elements))
+		for _, raw := range elements {
+			converted, err := policySpecValue(raw)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, converted)
+		}
+		if _, ok := value.Type().(tftypes.Set); ok {
+			if err := sortSpecElements(result); err != nil {
+				return nil, err
+			}
+		}
+		return result, nil
+	default:
+		return terraformValueToInterface(value)
+	}
+}
+
+// sortSpecElements orders set elements by their JSON encoding, so the order of
+// blocks in configuration does not change spec_json.
+func sortSpecElements(elements []interface{}) error {
+	keys := make(map[int]string, len(elements))
+	for i, element := range elements {
+		encoded, err := json.Marshal(element)
+		if err != nil {
+			return err
+		}
+		keys[i] = string(encoded)
+	}
+
+	indexes := make([]int, len(elements))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	sort.SliceStable(indexes, func(a, b int) bool { return keys[indexes[a]] < keys[indexes[b]] })
+
+	sorted := make([]interface{}, len(elements))
+	for i, index := range indexes {
+		sorted[i] = elements[index]
+	}
+	copy(elements, sorted)
+	return nil
+}
+
+func isSensitivePolicyKey(key string) bool {
+	return key == "api_key" || key == "app_key" || key == "bearer_token" || key == "headers"
+}
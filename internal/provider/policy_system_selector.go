@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2021, 2026
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/ctrlplanedev/terraform-provider-ctrlplane/internal/api"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// planPolicySystemSelector sets the planned selector from system_ids.
+// Release target selectors cannot refer to systems, and a deployment can be
+// linked to several of them, so system_ids is compiled into a selector over
+// the IDs of the deployments linked to those systems. Rebuilding it on every
+// plan means deployments linked to a system later show up as a change to the
+// policy. The selector stays unknown until apply while any system ID is.
+func planPolicySystemSelector(ctx context.Context, workspace *api.WorkspaceClient, plan *tfsdk.Plan) diag.Diagnostics {
+	var systemIDs types.Set
+	diags := plan.GetAttribute(ctx, path.Root("system_ids"), &systemIDs)
+	if diags.HasError() || systemIDs.IsNull() {
+		return diags
+	}
+	if workspace == nil || systemIDs.IsUnknown() || slices.ContainsFunc(systemIDs.Elements(), attr.Value.IsUnknown) {
+		diags.Append(plan.SetAttribute(ctx, path.Root("selector"), types.StringUnknown())...)
+		return diags
+	}
+
+	selector, err := policySystemSelector(ctx, workspace, systemIDs)
+	if err != nil {
+		diags.AddAttributeError(path.Root("system_ids"), "Invalid system_ids", err.Error())
+		return diags
+	}
+	diags.Append(plan.SetAttribute(ctx, path.Root("selector"), selector)...)
+	return diags
+}
+
+// policySystemSelector returns a selector matching every deployment linked to
+// one of systemIDs, or "false" when none are. It fails when a system does not
+// exist in the workspace.
+func policySystemSelector(ctx context.Context, workspace *api.WorkspaceClient, systemIDs types.Set) (string, error) {
+	var ids []string
+	if diags := systemIDs.ElementsAs(ctx, &ids, false); diags.HasError() {
+		return "", fmt.Errorf("failed to read system_ids")
+	}
+
+	systems, err := api.Collect(api.Paginate(ctx, systemPages(workspace)))
+	if err != nil {
+		return "", err
+	}
+	for _, id := range ids {
+		if !slices.ContainsFunc(systems, func(system api.System) bool { return system.Id == id }) {
+			return "", fmt.Errorf("no system with ID '%s' in workspace '%s'", id, workspace.ID.String())
+		}
+	}
+
+	deployments, err := listDeployments(ctx, workspace, nil)
+	if err != nil {
+		return "", err
+	}
+	var deploymentIDs []string
+	for _, item := range deployments {
+		for _, system := range item.Systems {
+			if slices.Contains(ids, system.Id) {
+				deploymentIDs = append(deploymentIDs, strconv.Quote(item.Deployment.Id))
+				break
+			}
+		}
+	}
+	if len(deploymentIDs) == 0 {
+		return "false", nil
+	}
+
+	slices.Sort(deploymentIDs)
+	return "deployment.id in [" + strings.Join(slices.Compact(deploymentIDs), ", ") + "]", nil
+}
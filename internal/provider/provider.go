@@ -5,13 +5,18 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/ctrlplanedev/terraform-provider-ctrlplane/internal/api"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -34,9 +39,44 @@ type CtrlplaneProvider struct {
 
 // CtrlplaneProviderModel describes the provider data model.
 type CtrlplaneProviderModel struct {
-	URL       types.String `tfsdk:"url"`
-	ApiKey    types.String `tfsdk:"api_key"`
-	Workspace types.String `tfsdk:"workspace"`
+	URL             types.String `tfsdk:"url"`
+	ApiKey          types.String `tfsdk:"api_key"`
+	ApiKeyFile      types.String `tfsdk:"api_key_file"`
+	Workspace       types.String `tfsdk:"workspace"`
+	DryRun          types.Bool   `tfsdk:"dry_run"`
+	DebugHTTP       types.Bool   `tfsdk:"debug_http"`
+	PlanSummaryFile types.String `tfsdk:"plan_summary_file"`
+	Preflight       types.Bool   `tfsdk:"preflight_check"`
+
+	MaxRetries    types.Int64  `tfsdk:"max_retries"`
+	RetryMinDelay types.String `tfsdk:"retry_min_delay"`
+	RetryMaxDelay types.String `tfsdk:"retry_max_delay"`
+
+	CircuitBreakerThreshold types.Int64 `tfsdk:"circuit_breaker_threshold"`
+
+	RequestsPerSecond types.Int64 `tfsdk:"requests_per_second"`
+	RequestBurst      types.Int64 `tfsdk:"request_burst"`
+
+	FailoverEndpoints []CtrlplaneProviderFailoverEndpointModel `tfsdk:"failover_endpoints"`
+
+	DefaultMetadata types.Map `tfsdk:"default_metadata"`
+
+	Features *CtrlplaneProviderFeaturesModel `tfsdk:"features"`
+}
+
+// CtrlplaneProviderFeaturesModel describes the features block. Unset flags
+// keep their defaults.
+type CtrlplaneProviderFeaturesModel struct {
+	UnmanagedConfigWarnings types.Bool `tfsdk:"unmanaged_config_warnings"`
+	OrphanedValueWarnings   types.Bool `tfsdk:"orphaned_value_warnings"`
+	MetadataNullIsEmpty     types.Bool `tfsdk:"metadata_null_is_empty"`
+}
+
+// CtrlplaneProviderFailoverEndpointModel describes one failover_endpoints
+// entry.
+type CtrlplaneProviderFailoverEndpointModel struct {
+	URL    types.String `tfsdk:"url"`
+	ApiKey types.String `tfsdk:"api_key"`
 }
 
 func (p *CtrlplaneProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -58,11 +98,115 @@ func (p *CtrlplaneProvider) Schema(ctx context.Context, req provider.SchemaReque
 				Optional:            true,
 			},
 			"api_key": schema.StringAttribute{
-				Description:         "The token to use for authentication. Can be set in the CTRLPLANE_API_KEY environment variable.",
-				MarkdownDescription: "The token to use for authentication. Can be set in the CTRLPLANE_API_KEY environment variable.",
+				Description:         "The token to use for authentication. Conflicts with api_key_file. When neither is set, the CTRLPLANE_API_KEY environment variable is used, then the api_key entry of ~/.ctrlplane/credentials.",
+				MarkdownDescription: "The token to use for authentication. Conflicts with `api_key_file`. When neither is set, the `CTRLPLANE_API_KEY` environment variable is used, then the `api_key` entry of `~/.ctrlplane/credentials`.",
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"api_key_file": schema.StringAttribute{
+				Description:         "Path to a file holding only the token to use for authentication, such as a mounted secret. Surrounding whitespace is ignored. Conflicts with api_key.",
+				MarkdownDescription: "Path to a file holding only the token to use for authentication, such as a mounted secret. Surrounding whitespace is ignored. Conflicts with `api_key`.",
+				Optional:            true,
+			},
+			"dry_run": schema.BoolAttribute{
+				Description:         "When true, reads are sent to the API but creates, updates, and deletes are not. Each resource fails at its first write, with the request it would have sent (credentials redacted) in the error and in the INFO log, so an apply reports one payload per changed resource and skips resources that depend on a failed one. Use it to check the requests a provider upgrade would send; it does not produce a full apply. Can be set in the CTRLPLANE_DRY_RUN environment variable.",
+				MarkdownDescription: "When true, reads are sent to the API but creates, updates, and deletes are not. Each resource fails at its first write, with the request it would have sent (credentials redacted) in the error and in the `INFO` log, so an apply reports one payload per changed resource and skips resources that depend on a failed one. Use it to check the requests a provider upgrade would send; it does not produce a full apply. Can be set in the `CTRLPLANE_DRY_RUN` environment variable.",
+				Optional:            true,
+			},
+			"debug_http": schema.BoolAttribute{
+				Description:         "When true, every API request and its response are logged at debug level, with API keys, tokens, secrets, and passwords redacted from headers and bodies. Set TF_LOG=DEBUG or TF_LOG_PROVIDER=DEBUG to see them. Can be set in the CTRLPLANE_DEBUG_HTTP environment variable.",
+				MarkdownDescription: "When true, every API request and its response are logged at debug level, with API keys, tokens, secrets, and passwords redacted from headers and bodies. Set `TF_LOG=DEBUG` or `TF_LOG_PROVIDER=DEBUG` to see them. Can be set in the `CTRLPLANE_DEBUG_HTTP` environment variable.",
+				Optional:            true,
+			},
+			"plan_summary_file": schema.StringAttribute{
+				Description:         "Path of a JSON file to write a summary of the changes planned for Ctrlplane resources to, for tools that post plan reviews to pull requests. Each change lists the resource type, operation, workspace, ID, name, and the names of changed attributes, without their values. The file is replaced when the provider is configured and rewritten as each resource is planned, so after terraform plan it holds that plan's changes; terraform apply plans again and rewrites it. Provider configurations that set the same path share the file. Can be set in the CTRLPLANE_PLAN_SUMMARY_FILE environment variable.",
+				MarkdownDescription: "Path of a JSON file to write a summary of the changes planned for Ctrlplane resources to, for tools that post plan reviews to pull requests. Each change lists the resource type, operation, workspace, ID, name, and the names of changed attributes, without their values. The file is replaced when the provider is configured and rewritten as each resource is planned, so after `terraform plan` it holds that plan's changes; `terraform apply` plans again and rewrites it. Provider configurations that set the same path share the file. Can be set in the `CTRLPLANE_PLAN_SUMMARY_FILE` environment variable.",
+				Optional:            true,
+			},
+			"preflight_check": schema.BoolAttribute{
+				Description:         "When true, the provider reads the configured workspace while it is configured and fails immediately if the API is unreachable, the API key is rejected, or the key cannot access the workspace. Can be set in the CTRLPLANE_PREFLIGHT_CHECK environment variable.",
+				MarkdownDescription: "When true, the provider reads the configured workspace while it is configured and fails immediately if the API is unreachable, the API key is rejected, or the key cannot access the workspace. Can be set in the `CTRLPLANE_PREFLIGHT_CHECK` environment variable.",
+				Optional:            true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description:         "How many times to retry a request that fails with a 429, 500, 502, 503, or 504 response or a network error. Only reads, upserts, and deletes are retried; creates are never repeated. Set to 0 to disable retries. Can be set in the CTRLPLANE_MAX_RETRIES environment variable. Defaults to 3.",
+				MarkdownDescription: "How many times to retry a request that fails with a 429, 500, 502, 503, or 504 response or a network error. Only reads, upserts, and deletes are retried; creates are never repeated. Set to 0 to disable retries. Can be set in the `CTRLPLANE_MAX_RETRIES` environment variable. Defaults to `3`.",
+				Optional:            true,
+			},
+			"retry_min_delay": schema.StringAttribute{
+				Description:         "Delay before the first retry, as a duration such as \"500ms\" or \"2s\". The delay doubles after each attempt. Can be set in the CTRLPLANE_RETRY_MIN_DELAY environment variable. Defaults to 500ms.",
+				MarkdownDescription: "Delay before the first retry, as a duration such as `\"500ms\"` or `\"2s\"`. The delay doubles after each attempt. Can be set in the `CTRLPLANE_RETRY_MIN_DELAY` environment variable. Defaults to `500ms`.",
+				Optional:            true,
+			},
+			"retry_max_delay": schema.StringAttribute{
+				Description:         "Upper bound on the delay between retries, as a duration such as \"30s\". Also caps the wait a Retry-After header asks for. Can be set in the CTRLPLANE_RETRY_MAX_DELAY environment variable. Defaults to 8s.",
+				MarkdownDescription: "Upper bound on the delay between retries, as a duration such as `\"30s\"`. Also caps the wait a `Retry-After` header asks for. Can be set in the `CTRLPLANE_RETRY_MAX_DELAY` environment variable. Defaults to `8s`.",
+				Optional:            true,
+			},
+			"circuit_breaker_threshold": schema.Int64Attribute{
+				Description:         "How many API requests may fail in a row, after retries, with a network error or a 429 or 5xx response before the provider stops sending requests. Once tripped, every remaining operation in the run fails immediately with the same error instead of retrying on its own. Set to 0 to disable. Can be set in the CTRLPLANE_CIRCUIT_BREAKER_THRESHOLD environment variable. Defaults to 5.",
+				MarkdownDescription: "How many API requests may fail in a row, after retries, with a network error or a 429 or 5xx response before the provider stops sending requests. Once tripped, every remaining operation in the run fails immediately with the same error instead of retrying on its own. Set to 0 to disable. Can be set in the `CTRLPLANE_CIRCUIT_BREAKER_THRESHOLD` environment variable. Defaults to `5`.",
+				Optional:            true,
+			},
+			"requests_per_second": schema.Int64Attribute{
+				Description:         "Average number of API requests per second the provider may send, shared by every resource and data source, including retries. Requests beyond it wait their turn instead of being rejected with a 429 response. Set to 0 for no limit. Can be set in the CTRLPLANE_REQUESTS_PER_SECOND environment variable. Defaults to 0.",
+				MarkdownDescription: "Average number of API requests per second the provider may send, shared by every resource and data source, including retries. Requests beyond it wait their turn instead of being rejected with a 429 response. Set to 0 for no limit. Can be set in the `CTRLPLANE_REQUESTS_PER_SECOND` environment variable. Defaults to `0`.",
+				Optional:            true,
+			},
+			"request_burst": schema.Int64Attribute{
+				Description:         "How many requests may be sent at once before requests_per_second applies. Can be set in the CTRLPLANE_REQUEST_BURST environment variable. Defaults to requests_per_second.",
+				MarkdownDescription: "How many requests may be sent at once before `requests_per_second` applies. Can be set in the `CTRLPLANE_REQUEST_BURST` environment variable. Defaults to `requests_per_second`.",
+				Optional:            true,
+			},
+			"failover_endpoints": schema.ListNestedAttribute{
+				Description:         "Replicas of the control plane to send requests to, in order, when the endpoints before them are unavailable. An endpoint that fails a request with a network error or a 502, 503, or 504 response after retries is skipped for 30 seconds, and the request moves on to the next one. Requests that are not safe to repeat, such as creates, are never resent to another endpoint. The replicas must serve the same workspace.",
+				MarkdownDescription: "Replicas of the control plane to send requests to, in order, when the endpoints before them are unavailable. An endpoint that fails a request with a network error or a 502, 503, or 504 response after retries is skipped for 30 seconds, and the request moves on to the next one. Requests that are not safe to repeat, such as creates, are never resent to another endpoint. The replicas must serve the same workspace.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"url": schema.StringAttribute{
+							Description:         "The URL of the replica.",
+							MarkdownDescription: "The URL of the replica.",
+							Required:            true,
+						},
+						"api_key": schema.StringAttribute{
+							Description:         "The token to use for the replica. Defaults to api_key.",
+							MarkdownDescription: "The token to use for the replica. Defaults to `api_key`.",
+							Optional:            true,
+							Sensitive:           true,
+						},
+					},
+				},
+			},
+			"default_metadata": schema.MapAttribute{
+				Description:         "Metadata added to every ctrlplane_system, ctrlplane_environment, ctrlplane_deployment, ctrlplane_policy, and ctrlplane_job_agent the provider creates or updates. A key set in a resource's metadata overrides the default. Default entries are left out of each resource's metadata attribute unless the resource sets the key itself, so they never show up as a diff.",
+				MarkdownDescription: "Metadata added to every `ctrlplane_system`, `ctrlplane_environment`, `ctrlplane_deployment`, `ctrlplane_policy`, and `ctrlplane_job_agent` the provider creates or updates. A key set in a resource's `metadata` overrides the default. Default entries are left out of each resource's `metadata` attribute unless the resource sets the key itself, so they never show up as a diff.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"features": schema.SingleNestedBlock{
+				Description:         "Turns optional provider behaviors on or off. New checks that are still settling ship here so they can be disabled per configuration.",
+				MarkdownDescription: "Turns optional provider behaviors on or off. New checks that are still settling ship here so they can be disabled per configuration.",
+				Attributes: map[string]schema.Attribute{
+					"unmanaged_config_warnings": schema.BoolAttribute{
+						Description:         "Warn when a job agent's configuration on the server has keys its typed block does not manage. They do not show up in plans, but the next update of the job agent removes them. Defaults to true.",
+						MarkdownDescription: "Warn when a job agent's configuration on the server has keys its typed block does not manage. They do not show up in plans, but the next update of the job agent removes them. Defaults to `true`.",
+						Optional:            true,
+					},
+					"orphaned_value_warnings": schema.BoolAttribute{
+						Description:         "Warn when a refreshed ctrlplane_deployment_variable_value belongs to a variable that no longer exists. Defaults to true.",
+						MarkdownDescription: "Warn when a refreshed `ctrlplane_deployment_variable_value` belongs to a variable that no longer exists. Defaults to `true`.",
+						Optional:            true,
+					},
+					"metadata_null_is_empty": schema.BoolAttribute{
+						Description:         "Store metadata that the API returns as missing or empty as an empty map on ctrlplane_system, ctrlplane_environment, ctrlplane_deployment, ctrlplane_policy, and ctrlplane_job_agent, matching what an unset metadata attribute plans to, and send unset metadata as an empty map. Set to false to store metadata exactly as the API returns it, where a missing map is null; an unset metadata attribute then plans to keep a stored null or empty map, so neither shows up as a diff. Defaults to true.",
+						MarkdownDescription: "Store metadata that the API returns as missing or empty as an empty map on `ctrlplane_system`, `ctrlplane_environment`, `ctrlplane_deployment`, `ctrlplane_policy`, and `ctrlplane_job_agent`, matching what an unset `metadata` attribute plans to, and send unset metadata as an empty map. Set to `false` to store metadata exactly as the API returns it, where a missing map is null; an unset `metadata` attribute then plans to keep a stored null or empty map, so neither shows up as a diff. Defaults to `true`.",
+						Optional:            true,
+					},
+				},
+			},
 		},
 	}
 }
@@ -85,13 +229,36 @@ func (p *CtrlplaneProvider) Configure(ctx context.Context, req provider.Configur
 		}
 	}
 
+	// The API key comes from api_key or api_key_file, then the environment,
+	// then the shared credentials file.
+	if !data.ApiKey.IsNull() && !data.ApiKeyFile.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("api_key_file"), "Conflicting API key configuration", "Only one of api_key and api_key_file can be set.")
+		return
+	}
+	if !data.ApiKeyFile.IsNull() {
+		apiKey, err := readAPIKeyFile(data.ApiKeyFile.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("api_key_file"), "Failed to read API key file", err.Error())
+			return
+		}
+		data.ApiKey = types.StringValue(apiKey)
+	}
 	if data.ApiKey.IsNull() {
-		envAPIKey := os.Getenv("CTRLPLANE_API_KEY")
-		if envAPIKey == "" {
-			resp.Diagnostics.AddError("API key not set", "The CTRLPLANE_API_KEY environment variable is not set")
+		if envAPIKey := os.Getenv("CTRLPLANE_API_KEY"); envAPIKey != "" {
+			data.ApiKey = types.StringValue(envAPIKey)
+		}
+	}
+	if data.ApiKey.IsNull() {
+		apiKey, credentialsPath, err := credentialsFileAPIKey()
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read credentials file", fmt.Sprintf("%s: %s", credentialsPath, err.Error()))
+			return
+		}
+		if apiKey == "" {
+			resp.Diagnostics.AddError("API key not set", "Set api_key or api_key_file in the provider configuration, the CTRLPLANE_API_KEY environment variable, or api_key in ~/.ctrlplane/credentials.")
 			return
 		}
-		data.ApiKey = types.StringValue(envAPIKey)
+		data.ApiKey = types.StringValue(apiKey)
 	}
 
 	// Set Workspace from environment if not provided.
@@ -104,25 +271,202 @@ func (p *CtrlplaneProvider) Configure(ctx context.Context, req provider.Configur
 		data.Workspace = types.StringValue(envWorkspace)
 	}
 
-	client, err := api.NewWorkspaceClient(data.URL.ValueString(), data.ApiKey.ValueString(), data.Workspace.ValueString())
+	if data.DryRun.IsNull() {
+		data.DryRun = types.BoolValue(os.Getenv("CTRLPLANE_DRY_RUN") == "true")
+	}
+
+	if data.DebugHTTP.IsNull() {
+		data.DebugHTTP = types.BoolValue(os.Getenv("CTRLPLANE_DEBUG_HTTP") == "true")
+	}
+
+	if data.PlanSummaryFile.IsNull() {
+		data.PlanSummaryFile = types.StringValue(os.Getenv("CTRLPLANE_PLAN_SUMMARY_FILE"))
+	}
+
+	if data.Preflight.IsNull() {
+		data.Preflight = types.BoolValue(os.Getenv("CTRLPLANE_PREFLIGHT_CHECK") == "true")
+	}
+
+	maxRetries, ok := int64Setting(data.MaxRetries, "max_retries", "CTRLPLANE_MAX_RETRIES", api.DefaultMaxRetries, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	retryMinDelay, ok := retryDelay(data.RetryMinDelay, "retry_min_delay", "CTRLPLANE_RETRY_MIN_DELAY", api.DefaultRetryMinDelay, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	retryMaxDelay, ok := retryDelay(data.RetryMaxDelay, "retry_max_delay", "CTRLPLANE_RETRY_MAX_DELAY", api.DefaultRetryMaxDelay, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	if retryMaxDelay < retryMinDelay {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_max_delay"),
+			"Invalid retry_max_delay",
+			fmt.Sprintf("retry_max_delay (%s) must not be less than retry_min_delay (%s)", retryMaxDelay, retryMinDelay),
+		)
+		return
+	}
+
+	circuitBreakerThreshold, ok := int64Setting(data.CircuitBreakerThreshold, "circuit_breaker_threshold", "CTRLPLANE_CIRCUIT_BREAKER_THRESHOLD", api.DefaultCircuitBreakerThreshold, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	requestsPerSecond, ok := int64Setting(data.RequestsPerSecond, "requests_per_second", "CTRLPLANE_REQUESTS_PER_SECOND", 0, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	requestBurst, ok := int64Setting(data.RequestBurst, "request_burst", "CTRLPLANE_REQUEST_BURST", requestsPerSecond, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	if requestsPerSecond > 0 && requestBurst < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("request_burst"), "Invalid request_burst", "request_burst must be at least 1 when requests_per_second is set")
+		return
+	}
+
+	failoverEndpoints := make([]api.FailoverEndpoint, 0, len(data.FailoverEndpoints))
+	for i, endpoint := range data.FailoverEndpoints {
+		if endpoint.URL.IsUnknown() || endpoint.URL.ValueString() == "" {
+			resp.Diagnostics.AddAttributeError(path.Root("failover_endpoints").AtListIndex(i).AtName("url"), "Invalid failover endpoint", "url must be set to a known, non-empty value")
+			return
+		}
+		failoverEndpoints = append(failoverEndpoints, api.FailoverEndpoint{
+			URL:    endpoint.URL.ValueString(),
+			APIKey: endpoint.ApiKey.ValueString(),
+		})
+	}
+
+	// WithRetry must come first: it configures the retrying HTTP client that
+	// later options wrap. Rate limiting and debug logging go inside it so
+	// every attempt is limited and logged. Failover moves a request to the
+	// next endpoint once retries are used up, the circuit breaker counts
+	// requests after both, and dry run sits outside it so that skipped
+	// writes are not failures.
+	clientOpts := []api.ClientOption{
+		api.WithRetry(int(maxRetries), retryMinDelay, retryMaxDelay),
+	}
+	if requestsPerSecond > 0 {
+		clientOpts = append(clientOpts, api.WithRateLimit(int(requestsPerSecond), int(requestBurst)))
+	}
+	if data.DebugHTTP.ValueBool() {
+		clientOpts = append(clientOpts, api.WithDebugHTTP())
+	}
+	clientOpts = append(clientOpts,
+		api.WithFailover(failoverEndpoints, api.DefaultFailoverCooldown),
+		api.WithCircuitBreaker(int(circuitBreakerThreshold)),
+	)
+	if data.DryRun.ValueBool() {
+		clientOpts = append(clientOpts, api.WithDryRun())
+	}
+
+	client, err := api.NewWorkspaceClient(ctx, data.URL.ValueString(), data.ApiKey.ValueString(), data.Workspace.ValueString(), clientOpts...)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create client", err.Error())
 		return
 	}
 
+	if data.Features != nil {
+		if !data.Features.UnmanagedConfigWarnings.IsNull() {
+			client.Features.UnmanagedConfigWarnings = data.Features.UnmanagedConfigWarnings.ValueBool()
+		}
+		if !data.Features.OrphanedValueWarnings.IsNull() {
+			client.Features.OrphanedValueWarnings = data.Features.OrphanedValueWarnings.ValueBool()
+		}
+		if !data.Features.MetadataNullIsEmpty.IsNull() {
+			client.Features.MetadataNullIsEmpty = data.Features.MetadataNullIsEmpty.ValueBool()
+		}
+	}
+
+	if !data.DefaultMetadata.IsNull() && !data.DefaultMetadata.IsUnknown() {
+		resp.Diagnostics.Append(data.DefaultMetadata.ElementsAs(ctx, &client.DefaultMetadata, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if file := data.PlanSummaryFile.ValueString(); file != "" {
+		if _, err := openPlanSummary(file); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("plan_summary_file"), "Failed to write plan summary", err.Error())
+			return
+		}
+		client.PlanSummaryFile = file
+	}
+
+	if data.Preflight.ValueBool() {
+		if _, err := client.CheckAccess(ctx); err != nil {
+			resp.Diagnostics.AddError("Provider preflight check failed", err.Error())
+			return
+		}
+	}
+
 	// Example client configuration for data sources and resources
 	resp.DataSourceData = client
 	resp.ResourceData = client
 }
 
+// int64Setting resolves a non-negative integer from the provider
+// configuration, then the environment, then the default. It reports false
+// after adding a diagnostic when the value is invalid.
+func int64Setting(value types.Int64, attribute, envVar string, fallback int64, diags *diag.Diagnostics) (int64, bool) {
+	if !value.IsNull() {
+		if value.ValueInt64() < 0 {
+			diags.AddAttributeError(path.Root(attribute), "Invalid "+attribute, attribute+" must be zero or greater")
+			return 0, false
+		}
+		return value.ValueInt64(), true
+	}
+
+	raw := os.Getenv(envVar)
+	if raw == "" {
+		return fallback, true
+	}
+	parsed, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || parsed < 0 {
+		diags.AddError("Invalid "+attribute, fmt.Sprintf("%s must be a non-negative integer, got %q", envVar, raw))
+		return 0, false
+	}
+	return parsed, true
+}
+
+// retryDelay resolves a retry delay from the provider configuration, then the
+// environment, then the default. It reports false after adding a diagnostic
+// when the value is not a positive duration.
+func retryDelay(value types.String, attribute, envVar string, fallback time.Duration, diags *diag.Diagnostics) (time.Duration, bool) {
+	raw := value.ValueString()
+	source := attribute
+	if value.IsNull() {
+		raw = os.Getenv(envVar)
+		source = envVar
+	}
+	if raw == "" {
+		return fallback, true
+	}
+
+	delay, err := time.ParseDuration(raw)
+	if err != nil || delay <= 0 {
+		diags.AddAttributeError(
+			path.Root(attribute),
+			"Invalid "+attribute,
+			fmt.Sprintf("%s must be a positive duration such as \"500ms\" or \"2s\", got %q", source, raw),
+		)
+		return 0, false
+	}
+	return delay, true
+}
+
 func (p *CtrlplaneProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewSystemResource,
 		NewEnvironmentResource,
 		NewDeploymentResource,
+		NewDeploymentVersionResource,
 		NewJobAgentResource,
 		NewDeploymentVariableResource,
 		NewDeploymentVariableValueResource,
+		NewDeploymentVariableValuesResource,
 		NewPolicyResource,
 		NewResourceResource,
 		NewResourceProviderResource,
@@ -142,6 +486,13 @@ func (p *CtrlplaneProvider) DataSources(ctx context.Context) []func() datasource
 	return []func() datasource.DataSource{
 		NewEnvironmentDataSource,
 		NewDeploymentDataSource,
+		NewDeploymentsDataSource,
+		NewPolicyDataSource,
+		NewSystemDataSource,
+		NewEnvironmentProgressionMatrixDataSource,
+		NewJobAgentTypesDataSource,
+		NewWorkspaceInventoryDataSource,
+		NewHealthDataSource,
 	}
 }
 
@@ -68,3 +68,9 @@ terraform {
 provider "ctrlplane" {}
 `
 }
+
+// testAccWorkspaceImportID prefixes an import ID with the workspace the
+// acceptance tests run in, as given in CTRLPLANE_WORKSPACE.
+func testAccWorkspaceImportID(key string) string {
+	return os.Getenv("CTRLPLANE_WORKSPACE") + "/" + key
+}
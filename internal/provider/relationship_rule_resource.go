@@ -21,6 +21,7 @@ import (
 var _ resource.Resource = &RelationshipRuleResource{}
 var _ resource.ResourceWithImportState = &RelationshipRuleResource{}
 var _ resource.ResourceWithConfigure = &RelationshipRuleResource{}
+var _ resource.ResourceWithModifyPlan = &RelationshipRuleResource{}
 
 func NewRelationshipRuleResource() resource.Resource {
 	return &RelationshipRuleResource{}
@@ -43,6 +44,10 @@ func (r *RelationshipRuleResource) Metadata(ctx context.Context, req resource.Me
 	resp.TypeName = req.ProviderTypeName + "_relationship_rule"
 }
 
+func (r *RelationshipRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	recordPlannedChange(ctx, r.workspace, "ctrlplane_relationship_rule", req, resp)
+}
+
 func (r *RelationshipRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -145,7 +150,7 @@ func (r *RelationshipRuleResource) Create(ctx context.Context, req resource.Crea
 	}
 
 	if createResp.StatusCode() != http.StatusCreated {
-		resp.Diagnostics.AddError("Failed to create relationship rule", formatResponseError(createResp.StatusCode(), createResp.Body))
+		addResponseError(ctx, &resp.Diagnostics, "Failed to create relationship rule", req.Plan, createResp.HTTPResponse, createResp.Body)
 		return
 	}
 
@@ -209,7 +214,7 @@ func (r *RelationshipRuleResource) Read(ctx context.Context, req resource.ReadRe
 		resp.State.RemoveResource(ctx)
 		return
 	default:
-		resp.Diagnostics.AddError("Failed to read relationship rule", formatResponseError(ruleResp.StatusCode(), ruleResp.Body))
+		resp.Diagnostics.AddError("Failed to read relationship rule", formatResponseError(ruleResp.HTTPResponse, ruleResp.Body))
 		return
 	}
 
@@ -269,7 +274,7 @@ func (r *RelationshipRuleResource) Update(ctx context.Context, req resource.Upda
 	switch upsertResp.StatusCode() {
 	case http.StatusOK, http.StatusAccepted:
 	default:
-		resp.Diagnostics.AddError("Failed to update relationship rule", formatResponseError(upsertResp.StatusCode(), upsertResp.Body))
+		addResponseError(ctx, &resp.Diagnostics, "Failed to update relationship rule", req.Plan, upsertResp.HTTPResponse, upsertResp.Body)
 		return
 	}
 
@@ -299,7 +304,7 @@ func (r *RelationshipRuleResource) Delete(ctx context.Context, req resource.Dele
 	case http.StatusNotFound:
 		return
 	default:
-		resp.Diagnostics.AddError("Failed to delete relationship rule", formatResponseError(deleteResp.StatusCode(), deleteResp.Body))
+		resp.Diagnostics.AddError("Failed to delete relationship rule", formatResponseError(deleteResp.HTTPResponse, deleteResp.Body))
 		return
 	}
 }
@@ -25,6 +25,7 @@ import (
 var _ resource.Resource = &ResourceProviderResource{}
 var _ resource.ResourceWithImportState = &ResourceProviderResource{}
 var _ resource.ResourceWithConfigure = &ResourceProviderResource{}
+var _ resource.ResourceWithModifyPlan = &ResourceProviderResource{}
 
 func NewResourceProviderResource() resource.Resource {
 	return &ResourceProviderResource{}
@@ -38,6 +39,10 @@ func (r *ResourceProviderResource) Metadata(ctx context.Context, req resource.Me
 	resp.TypeName = req.ProviderTypeName + "_resource_provider"
 }
 
+func (r *ResourceProviderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	recordPlannedChange(ctx, r.workspace, "ctrlplane_resource_provider", req, resp)
+}
+
 func (r *ResourceProviderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
 }
@@ -150,7 +155,7 @@ func (r *ResourceProviderResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 	if upsertResp.StatusCode() != http.StatusAccepted {
-		resp.Diagnostics.AddError("Failed to create resource provider", formatResponseError(upsertResp.StatusCode(), upsertResp.Body))
+		addResponseError(ctx, &resp.Diagnostics, "Failed to create resource provider", req.Plan, upsertResp.HTTPResponse, upsertResp.Body)
 		return
 	}
 	if upsertResp.JSON202 == nil {
@@ -205,7 +210,7 @@ func (r *ResourceProviderResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	default:
 		resp.Diagnostics.AddError("Failed to read resource provider",
-			formatResponseError(providerResp.StatusCode(), providerResp.Body))
+			formatResponseError(providerResp.HTTPResponse, providerResp.Body))
 		return
 	}
 
@@ -225,7 +230,7 @@ func (r *ResourceProviderResource) Read(ctx context.Context, req resource.ReadRe
 	}
 	if resourcesResp.StatusCode() != http.StatusOK || resourcesResp.JSON200 == nil {
 		resp.Diagnostics.AddError("Failed to list provider resources",
-			formatResponseError(resourcesResp.StatusCode(), resourcesResp.Body))
+			formatResponseError(resourcesResp.HTTPResponse, resourcesResp.Body))
 		return
 	}
 
@@ -272,7 +277,7 @@ func (r *ResourceProviderResource) Update(ctx context.Context, req resource.Upda
 	}
 	if upsertResp.StatusCode() != http.StatusAccepted {
 		resp.Diagnostics.AddError("Failed to update resource provider",
-			formatResponseError(upsertResp.StatusCode(), upsertResp.Body))
+			formatResponseError(upsertResp.HTTPResponse, upsertResp.Body))
 		return
 	}
 
@@ -341,7 +346,7 @@ func (r *ResourceProviderResource) setResources(ctx context.Context, providerID
 		return err
 	}
 	if setResp.StatusCode() != http.StatusAccepted {
-		return fmt.Errorf("%s", formatResponseError(setResp.StatusCode(), setResp.Body))
+		return fmt.Errorf("%s", formatResponseError(setResp.HTTPResponse, setResp.Body))
 	}
 	return nil
 }
@@ -360,7 +365,7 @@ func (r *ResourceProviderResource) deleteResource(ctx context.Context, identifie
 	case http.StatusAccepted, http.StatusNoContent, http.StatusNotFound:
 		return nil
 	default:
-		return fmt.Errorf("%s", formatResponseError(deleteResp.StatusCode(), deleteResp.Body))
+		return fmt.Errorf("%s", formatResponseError(deleteResp.HTTPResponse, deleteResp.Body))
 	}
 }
 
@@ -24,6 +24,7 @@ import (
 var _ resource.Resource = &ResourceResource{}
 var _ resource.ResourceWithImportState = &ResourceResource{}
 var _ resource.ResourceWithConfigure = &ResourceResource{}
+var _ resource.ResourceWithModifyPlan = &ResourceResource{}
 
 func NewResourceResource() resource.Resource {
 	return &ResourceResource{}
@@ -37,6 +38,10 @@ func (r *ResourceResource) Metadata(ctx context.Context, req resource.MetadataRe
 	resp.TypeName = req.ProviderTypeName + "_resource"
 }
 
+func (r *ResourceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	recordPlannedChange(ctx, r.workspace, "ctrlplane_resource", req, resp)
+}
+
 func (r *ResourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -149,7 +154,7 @@ func (r *ResourceResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	if patchResp.StatusCode() != http.StatusAccepted {
-		resp.Diagnostics.AddError("Failed to create resource", formatResponseError(patchResp.StatusCode(), patchResp.Body))
+		addResponseError(ctx, &resp.Diagnostics, "Failed to create resource", req.Plan, patchResp.HTTPResponse, patchResp.Body)
 		return
 	}
 
@@ -214,7 +219,7 @@ func (r *ResourceResource) Read(ctx context.Context, req resource.ReadRequest, r
 		resp.State.RemoveResource(ctx)
 		return
 	default:
-		resp.Diagnostics.AddError("Failed to read resource", formatResponseError(resourceResp.StatusCode(), resourceResp.Body))
+		resp.Diagnostics.AddError("Failed to read resource", formatResponseError(resourceResp.HTTPResponse, resourceResp.Body))
 		return
 	}
 
@@ -284,7 +289,7 @@ func (r *ResourceResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	if patchResp.StatusCode() != http.StatusAccepted {
-		resp.Diagnostics.AddError("Failed to update resource", formatResponseError(patchResp.StatusCode(), patchResp.Body))
+		addResponseError(ctx, &resp.Diagnostics, "Failed to update resource", req.Plan, patchResp.HTTPResponse, patchResp.Body)
 		return
 	}
 
@@ -314,7 +319,7 @@ func (r *ResourceResource) Delete(ctx context.Context, req resource.DeleteReques
 	case http.StatusNotFound:
 		return
 	default:
-		resp.Diagnostics.AddError("Failed to delete resource", formatResponseError(deleteResp.StatusCode(), deleteResp.Body))
+		resp.Diagnostics.AddError("Failed to delete resource", formatResponseError(deleteResp.HTTPResponse, deleteResp.Body))
 		return
 	}
 }
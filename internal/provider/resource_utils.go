@@ -4,11 +4,16 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/ctrlplanedev/terraform-provider-ctrlplane/internal/api"
+	ctrlplanevalidator "github.com/ctrlplanedev/terraform-provider-ctrlplane/internal/validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -42,36 +47,77 @@ func stringMapValue(value *map[string]string) types.Map {
 	return result
 }
 
+// entityURLs returns the workspace app_url and the entity_url of the object
+// with the given ID in collection, or nulls when the links cannot be built.
+func entityURLs(workspace *api.WorkspaceClient, collection string, id types.String) (types.String, types.String) {
+	appURL := types.StringNull()
+	if app := workspace.AppURL(); app != "" {
+		appURL = types.StringValue(app)
+	}
+
+	if id.IsNull() || id.IsUnknown() {
+		return appURL, types.StringNull()
+	}
+	if entity := workspace.EntityURL(collection, id.ValueString()); entity != "" {
+		return appURL, types.StringValue(entity)
+	}
+	return appURL, types.StringNull()
+}
+
 const waitForResourceTimeout = 5 * time.Minute
 
-// waitForResource polls check until it returns true or 5 minutes have elapsed.
+// waitForResource polls check until it returns true, ctx's deadline passes, or
+// 5 minutes have elapsed when ctx has no deadline.
 // check should return (true, nil) when the resource exists, (false, nil) to keep
 // polling, or (false, err) to abort immediately. Uses exponential backoff starting
-// at 1s and capped at 10s.
+// at 1s and capped at 10s. Cancellation of ctx (e.g. Ctrl-C) stops polling
+// immediately with a "canceled by user" error instead of waiting out the timeout.
 func waitForResource(ctx context.Context, check func() (bool, error)) error {
-	deadline := time.Now().Add(waitForResourceTimeout)
+	timeout := waitForResourceTimeout
+	if ctxDeadline, ok := ctx.Deadline(); ok {
+		timeout = time.Until(ctxDeadline)
+	}
+	deadline := time.Now().Add(timeout)
 	interval := 1 * time.Second
 
 	for {
+		if err := ctx.Err(); err != nil {
+			return waitCanceledError(err)
+		}
 		exists, err := check()
 		if err != nil {
+			if ctx.Err() != nil {
+				return waitCanceledError(ctx.Err())
+			}
 			return err
 		}
 		if exists {
 			return nil
 		}
 		if time.Now().After(deadline) {
-			return fmt.Errorf("resource not found after %s", waitForResourceTimeout)
+			return fmt.Errorf("resource not found after %s", timeout.Round(time.Second))
 		}
+		timer := time.NewTimer(interval)
 		select {
 		case <-ctx.Done():
-			return ctx.Err()
-		case <-time.After(interval):
+			timer.Stop()
+			return waitCanceledError(ctx.Err())
+		case <-timer.C:
 		}
 		interval = min(interval*2, 10*time.Second)
 	}
 }
 
+func waitCanceledError(err error) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("timed out waiting for resource: %w", err)
+	}
+	if errors.Is(err, context.Canceled) {
+		return fmt.Errorf("canceled by user while waiting for resource: %w", err)
+	}
+	return fmt.Errorf("stopped waiting for resource: %w", err)
+}
+
 func normalizeCEL(value types.String) string {
 	if value.IsNull() || value.IsUnknown() {
 		return ""
@@ -105,3 +151,69 @@ func (celNormalizedPlanModifier) PlanModifyString(_ context.Context, req planmod
 func celNormalized() planmodifier.String {
 	return celNormalizedPlanModifier{}
 }
+
+// celValidators rejects selectors that do not parse as CEL at plan time, with
+// the parser's line and column in the diagnostic.
+func celValidators() []validator.String {
+	return []validator.String{ctrlplanevalidator.NewCELValidator()}
+}
+
+func rruleValidators() []validator.String {
+	return []validator.String{ctrlplanevalidator.NewRRuleValidator()}
+}
+
+// selectorCanonicalPlanModifier carries the server-canonical form of a CEL
+// selector forward from state while the configured selector is unchanged, and
+// marks it unknown otherwise so the next apply can record the new server form.
+type selectorCanonicalPlanModifier struct {
+	selector path.Path
+}
+
+func (m selectorCanonicalPlanModifier) Description(_ context.Context) string {
+	return fmt.Sprintf("Uses the prior state value unless %s changes.", m.selector)
+}
+
+func (m selectorCanonicalPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m selectorCanonicalPlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.State.Raw.IsNull() || !req.PlanValue.IsUnknown() {
+		return
+	}
+
+	var planned, prior types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, m.selector, &planned)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, m.selector, &prior)...)
+	if resp.Diagnostics.HasError() || planned.IsUnknown() {
+		return
+	}
+
+	if normalizeCEL(planned) == normalizeCEL(prior) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+func selectorCanonical(selector path.Path) planmodifier.String {
+	return selectorCanonicalPlanModifier{selector: selector}
+}
+
+// reconcileSelector compares the selector returned by the API with the
+// canonical form recorded in state. When the server still holds the recorded
+// canonical form, or a whitespace-equivalent of the configured expression, the
+// configured expression is kept so server-side rewriting does not show up as
+// drift. The returned canonical value always reflects the server.
+func reconcileSelector(configured, canonical types.String, server *string) (types.String, types.String) {
+	serverValue := types.StringNull()
+	if server != nil && *server != "" {
+		serverValue = types.StringValue(*server)
+	}
+
+	if !configured.IsNull() && !serverValue.IsNull() {
+		if serverValue.Equal(canonical) || normalizeCEL(serverValue) == normalizeCEL(configured) {
+			return configured, serverValue
+		}
+	}
+
+	return serverValue, serverValue
+}
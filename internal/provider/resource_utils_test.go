@@ -0,0 +1,235 @@
+// Copyright IBM Corp. 2021, 2026
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestReconcileSelector(t *testing.T) {
+	ptr := func(s string) *string { return &s }
+
+	cases := map[string]struct {
+		configured    types.String
+		canonical     types.String
+		server        *string
+		wantSelector  types.String
+		wantCanonical types.String
+	}{
+		"server returns configured selector": {
+			configured:    types.StringValue("resource.kind == 'Pod'"),
+			canonical:     types.StringNull(),
+			server:        ptr("resource.kind == 'Pod'"),
+			wantSelector:  types.StringValue("resource.kind == 'Pod'"),
+			wantCanonical: types.StringValue("resource.kind == 'Pod'"),
+		},
+		"server collapses whitespace": {
+			configured:    types.StringValue("resource.kind ==\n  'Pod'"),
+			canonical:     types.StringNull(),
+			server:        ptr("resource.kind == 'Pod'"),
+			wantSelector:  types.StringValue("resource.kind ==\n  'Pod'"),
+			wantCanonical: types.StringValue("resource.kind == 'Pod'"),
+		},
+		"server keeps recorded canonical form": {
+			configured:    types.StringValue("(resource.kind == 'Pod')"),
+			canonical:     types.StringValue("resource.kind == \"Pod\""),
+			server:        ptr("resource.kind == \"Pod\""),
+			wantSelector:  types.StringValue("(resource.kind == 'Pod')"),
+			wantCanonical: types.StringValue("resource.kind == \"Pod\""),
+		},
+		"server selector changed out of band": {
+			configured:    types.StringValue("(resource.kind == 'Pod')"),
+			canonical:     types.StringValue("resource.kind == \"Pod\""),
+			server:        ptr("resource.kind == \"Node\""),
+			wantSelector:  types.StringValue("resource.kind == \"Node\""),
+			wantCanonical: types.StringValue("resource.kind == \"Node\""),
+		},
+		"server selector removed": {
+			configured:    types.StringValue("resource.kind == 'Pod'"),
+			canonical:     types.StringValue("resource.kind == 'Pod'"),
+			server:        ptr(""),
+			wantSelector:  types.StringNull(),
+			wantCanonical: types.StringNull(),
+		},
+		"no selector configured": {
+			configured:    types.StringNull(),
+			canonical:     types.StringNull(),
+			server:        ptr("resource.kind == 'Pod'"),
+			wantSelector:  types.StringValue("resource.kind == 'Pod'"),
+			wantCanonical: types.StringValue("resource.kind == 'Pod'"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			selector, canonical := reconcileSelector(tc.configured, tc.canonical, tc.server)
+			if !selector.Equal(tc.wantSelector) {
+				t.Errorf("selector: got %s, want %s", selector, tc.wantSelector)
+			}
+			if !canonical.Equal(tc.wantCanonical) {
+				t.Errorf("canonical: got %s, want %s", canonical, tc.wantCanonical)
+			}
+		})
+	}
+}
+
+func TestSelectorCanonicalPlanModifier(t *testing.T) {
+	ctx := context.Background()
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"selector":  schema.StringAttribute{Optional: true},
+			"canonical": schema.StringAttribute{Computed: true},
+		},
+	}
+	objectType := testSchema.Type().TerraformType(ctx)
+	object := func(selector, canonical interface{}) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"selector":  tftypes.NewValue(tftypes.String, selector),
+			"canonical": tftypes.NewValue(tftypes.String, canonical),
+		})
+	}
+
+	cases := map[string]struct {
+		state tftypes.Value
+		plan  tftypes.Value
+		want  types.String
+	}{
+		"create": {
+			state: tftypes.NewValue(objectType, nil),
+			plan:  object("a == 1", tftypes.UnknownValue),
+			want:  types.StringUnknown(),
+		},
+		"selector unchanged": {
+			state: object("a == 1", "a==1"),
+			plan:  object("a == 1", tftypes.UnknownValue),
+			want:  types.StringValue("a==1"),
+		},
+		"selector whitespace changed": {
+			state: object("a == 1", "a==1"),
+			plan:  object("a ==\n  1", tftypes.UnknownValue),
+			want:  types.StringValue("a==1"),
+		},
+		"selector changed": {
+			state: object("a == 1", "a==1"),
+			plan:  object("(a == 1)", tftypes.UnknownValue),
+			want:  types.StringUnknown(),
+		},
+		"selector unknown": {
+			state: object("a == 1", "a==1"),
+			plan:  object(tftypes.UnknownValue, tftypes.UnknownValue),
+			want:  types.StringUnknown(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			state := tfsdk.State{Schema: testSchema, Raw: tc.state}
+			plan := tfsdk.Plan{Schema: testSchema, Raw: tc.plan}
+
+			var stateValue, planValue types.String
+			if !tc.state.IsNull() {
+				state.GetAttribute(ctx, path.Root("canonical"), &stateValue)
+			}
+			plan.GetAttribute(ctx, path.Root("canonical"), &planValue)
+
+			req := planmodifier.StringRequest{
+				Path:       path.Root("canonical"),
+				State:      state,
+				StateValue: stateValue,
+				Plan:       plan,
+				PlanValue:  planValue,
+			}
+			resp := &planmodifier.StringResponse{PlanValue: planValue}
+			selectorCanonical(path.Root("selector")).PlanModifyString(ctx, req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if !resp.PlanValue.Equal(tc.want) {
+				t.Errorf("got %s, want %s", resp.PlanValue, tc.want)
+			}
+		})
+	}
+}
+
+func TestWaitForResourceStopsOnContextDone(t *testing.T) {
+	cases := map[string]struct {
+		ctx       func() (context.Context, context.CancelFunc)
+		checkErr  bool
+		wantErr   error
+		wantInErr string
+	}{
+		"canceled while waiting": {
+			ctx: func() (context.Context, context.CancelFunc) {
+				ctx, cancel := context.WithCancel(context.Background())
+				time.AfterFunc(50*time.Millisecond, cancel)
+				return ctx, cancel
+			},
+			wantErr:   context.Canceled,
+			wantInErr: "canceled by user while waiting for resource",
+		},
+		"deadline while waiting": {
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(context.Background(), 50*time.Millisecond)
+			},
+			wantErr:   context.DeadlineExceeded,
+			wantInErr: "timed out waiting for resource",
+		},
+		"check fails because ctx was canceled": {
+			ctx: func() (context.Context, context.CancelFunc) {
+				ctx, cancel := context.WithCancel(context.Background())
+				time.AfterFunc(50*time.Millisecond, cancel)
+				return ctx, cancel
+			},
+			checkErr:  true,
+			wantErr:   context.Canceled,
+			wantInErr: "canceled by user while waiting for resource",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := tc.ctx()
+			defer cancel()
+
+			var checks atomic.Int32
+			start := time.Now()
+			err := waitForResource(ctx, func() (bool, error) {
+				checks.Add(1)
+				if tc.checkErr {
+					<-ctx.Done()
+					return false, errors.New("request failed")
+				}
+				return false, nil
+			})
+			elapsed := time.Since(start)
+
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("got error %v, want it to wrap %v", err, tc.wantErr)
+			}
+			if !strings.Contains(err.Error(), tc.wantInErr) {
+				t.Errorf("got error %q, want it to contain %q", err, tc.wantInErr)
+			}
+			// The first poll interval is 1s, so returning well before it
+			// means polling stopped as soon as ctx was done.
+			if elapsed > 500*time.Millisecond {
+				t.Errorf("waitForResource returned after %s, want it to stop promptly", elapsed)
+			}
+			if got := checks.Load(); got != 1 {
+				t.Errorf("got %d checks, want 1", got)
+			}
+		})
+	}
+}
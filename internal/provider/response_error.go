@@ -0,0 +1,201 @@
+// Copyright IBM Corp. 2021, 2026
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// apiError is an error response from the API. The API answers errors with
+// either {"error": "..."} or {"code": "...", "message": "...", "details": {}},
+// and validation failures list the offending fields in details.
+type apiError struct {
+	Status    int
+	Code      string
+	Message   string
+	RequestID string
+	Fields    []apiFieldError
+	// Raw is the response body when it could not be parsed.
+	Raw string
+}
+
+// apiFieldError is a validation error for one field of the request body.
+// Path holds the field's JSON path segments, e.g. ["rules", "0", "selector"].
+type apiFieldError struct {
+	Path    []string
+	Message string
+}
+
+func parseAPIError(httpResp *http.Response, body []byte) apiError {
+	var e apiError
+	if httpResp != nil {
+		e.Status = httpResp.StatusCode
+		e.RequestID = httpResp.Header.Get("X-Request-Id")
+	}
+
+	trimmed := strings.TrimSpace(string(body))
+	var decoded map[string]interface{}
+	if trimmed == "" || json.Unmarshal(body, &decoded) != nil {
+		e.Raw = trimmed
+		return e
+	}
+
+	e.Code = stringField(decoded, "code")
+	e.Message = stringField(decoded, "message")
+	if e.RequestID == "" {
+		e.RequestID = stringField(decoded, "requestId")
+	}
+	switch inner := decoded["error"].(type) {
+	case string:
+		if e.Message == "" {
+			e.Message = inner
+		} else {
+			e.Message = inner + ": " + e.Message
+		}
+	case map[string]interface{}:
+		if e.Code == "" {
+			e.Code = stringField(inner, "code")
+		}
+		if e.Message == "" {
+			e.Message = stringField(inner, "message")
+		}
+		decoded = inner
+	}
+
+	e.Fields = fieldErrors(decoded["issues"])
+	if details, ok := decoded["details"].(map[string]interface{}); ok {
+		e.Fields = append(e.Fields, fieldErrors(details["issues"])...)
+		e.Fields = append(e.Fields, fieldErrors(details["fieldErrors"])...)
+	}
+
+	if e.Message == "" && len(e.Fields) == 0 {
+		e.Raw = trimmed
+	}
+	return e
+}
+
+// fieldErrors reads a list of {"path": [...] or "a.b", "message": "..."}
+// entries, or a map from field path to messages.
+func fieldErrors(value interface{}) []apiFieldError {
+	var fields []apiFieldError
+	switch v := value.(type) {
+	case []interface{}:
+		for _, item := range v {
+			entry, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			field := apiFieldError{Message: stringField(entry, "message")}
+			switch p := entry["path"].(type) {
+			case []interface{}:
+				for _, segment := range p {
+					field.Path = append(field.Path, fmt.Sprint(segment))
+				}
+			case string:
+				field.Path = strings.Split(p, ".")
+			}
+			fields = append(fields, field)
+		}
+	case map[string]interface{}:
+		for key, messages := range v {
+			var texts []string
+			if list, ok := messages.([]interface{}); ok {
+				for _, message := range list {
+					texts = append(texts, fmt.Sprint(message))
+				}
+			} else {
+				texts = append(texts, fmt.Sprint(messages))
+			}
+			fields = append(fields, apiFieldError{Path: strings.Split(key, "."), Message: strings.Join(texts, "; ")})
+		}
+	}
+	return fields
+}
+
+func stringField(m map[string]interface{}, key string) string {
+	if s, ok := m[key].(string); ok {
+		return s
+	}
+	return ""
+}
+
+func (e apiError) String() string {
+	var b strings.Builder
+	switch {
+	case e.Status == 0:
+		b.WriteString("Missing response status from server")
+	case e.Code != "":
+		fmt.Fprintf(&b, "Status %d (%s)", e.Status, e.Code)
+	default:
+		fmt.Fprintf(&b, "Status %d", e.Status)
+	}
+
+	switch {
+	case e.Message != "":
+		b.WriteString(": " + e.Message)
+	case e.Raw != "":
+		b.WriteString(": " + e.Raw)
+	case e.Status != 0 && len(e.Fields) == 0:
+		b.WriteString(": " + http.StatusText(e.Status))
+	}
+
+	for _, field := range e.Fields {
+		fmt.Fprintf(&b, "\n  - %s: %s", strings.Join(field.Path, "."), field.Message)
+	}
+	if e.RequestID != "" {
+		fmt.Fprintf(&b, "\nRequest ID: %s", e.RequestID)
+	}
+	return b.String()
+}
+
+func formatResponseError(httpResp *http.Response, body []byte) string {
+	return parseAPIError(httpResp, body).String()
+}
+
+// addResponseError adds an error for a failed create or update. Each field
+// error whose top-level field is an attribute of the resource is also added
+// to that attribute, so Terraform points at it in the configuration.
+func addResponseError(ctx context.Context, diags *diag.Diagnostics, summary string, plan tfsdk.Plan, httpResp *http.Response, body []byte) {
+	e := parseAPIError(httpResp, body)
+	diags.AddError(summary, e.String())
+
+	for _, field := range e.Fields {
+		if len(field.Path) == 0 {
+			continue
+		}
+		attribute := path.Root(snakeCase(field.Path[0]))
+		if _, lookupDiags := plan.Schema.AttributeAtPath(ctx, attribute); lookupDiags.HasError() {
+			continue
+		}
+		diags.AddAttributeError(attribute, summary, fmt.Sprintf("%s: %s", strings.Join(field.Path, "."), field.Message))
+	}
+}
+
+// snakeCase converts an API field name such as resourceSelector to the
+// attribute name resource_selector. List indexes are returned unchanged.
+func snakeCase(name string) string {
+	if _, err := strconv.Atoi(name); err == nil {
+		return name
+	}
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2021, 2026
+
+package provider
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestFormatResponseError(t *testing.T) {
+	header := http.Header{}
+	header.Set("X-Request-Id", "req-123")
+
+	cases := map[string]struct {
+		resp *http.Response
+		body string
+		want string
+	}{
+		"error string": {
+			resp: &http.Response{StatusCode: http.StatusNotFound, Header: http.Header{}},
+			body: `{"error": "Policy not found"}`,
+			want: "Status 404: Policy not found",
+		},
+		"field errors": {
+			resp: &http.Response{StatusCode: http.StatusBadRequest, Header: header},
+			body: `{"code": "invalid_request", "message": "Validation failed", "details": {"issues": [{"path": ["rules", 0, "selector"], "message": "invalid CEL"}]}}`,
+			want: "Status 400 (invalid_request): Validation failed\n  - rules.0.selector: invalid CEL\nRequest ID: req-123",
+		},
+		"unparsed body": {
+			resp: &http.Response{StatusCode: http.StatusBadGateway, Header: http.Header{}},
+			body: "upstream unavailable\n",
+			want: "Status 502: upstream unavailable",
+		},
+		"empty body": {
+			resp: &http.Response{StatusCode: http.StatusInternalServerError, Header: http.Header{}},
+			want: "Status 500: Internal Server Error",
+		},
+		"no response": {
+			want: "Missing response status from server",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := formatResponseError(tc.resp, []byte(tc.body)); got != tc.want {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSnakeCase(t *testing.T) {
+	for in, want := range map[string]string{
+		"resourceSelector": "resource_selector",
+		"name":             "name",
+		"0":                "0",
+	} {
+		if got := snakeCase(in); got != want {
+			t.Errorf("snakeCase(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
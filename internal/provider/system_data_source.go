@@ -0,0 +1,196 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/ctrlplanedev/terraform-provider-ctrlplane/internal/api"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &SystemDataSource{}
+var _ datasource.DataSourceWithConfigure = &SystemDataSource{}
+
+func NewSystemDataSource() datasource.DataSource {
+	return &SystemDataSource{}
+}
+
+type SystemDataSource struct {
+	workspace *api.WorkspaceClient
+}
+
+type SystemDataSourceModel struct {
+	ID           types.String                  `tfsdk:"id"`
+	Slug         types.String                  `tfsdk:"slug"`
+	Name         types.String                  `tfsdk:"name"`
+	Description  types.String                  `tfsdk:"description"`
+	Metadata     types.Map                     `tfsdk:"metadata"`
+	Environments []SystemDataSourceEnvironment `tfsdk:"environments"`
+	AppURL       types.String                  `tfsdk:"app_url"`
+	EntityURL    types.String                  `tfsdk:"entity_url"`
+}
+
+type SystemDataSourceEnvironment struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+func (d *SystemDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system"
+}
+
+func (d *SystemDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetch an existing system by slug within the configured workspace, along with the environments linked to it.",
+		Attributes: map[string]schema.Attribute{
+			"app_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "Link to the workspace in the Ctrlplane UI",
+			},
+			"entity_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "Link to this system in the Ctrlplane UI",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the system",
+			},
+			"slug": schema.StringAttribute{
+				Required:    true,
+				Description: "The slug of the system to look up",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the system",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "The description of the system",
+			},
+			"metadata": schema.MapAttribute{
+				Computed:    true,
+				Description: "The metadata of the system",
+				ElementType: types.StringType,
+			},
+			"environments": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The environments linked to the system, ordered as returned by the API",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the environment",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the environment",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SystemDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	workspace, ok := req.ProviderData.(*api.WorkspaceClient)
+	if !ok {
+		resp.Diagnostics.AddError("Invalid provider data", "The provider data is not a *api.WorkspaceClient")
+		return
+	}
+
+	d.workspace = workspace
+}
+
+func (d *SystemDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SystemDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	systemID, err := findSystemIDBySlug(ctx, d.workspace, data.Slug.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read system", err.Error())
+		return
+	}
+	if systemID == "" {
+		resp.Diagnostics.AddError(
+			"System not found",
+			fmt.Sprintf("No system with slug '%s' in workspace '%s'", data.Slug.ValueString(), d.workspace.ID.String()),
+		)
+		return
+	}
+
+	systemResp, err := d.workspace.Client.GetSystemWithResponse(ctx, d.workspace.ID.String(), systemID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read system",
+			fmt.Sprintf("Failed to read system with ID '%s': %s", systemID, err.Error()),
+		)
+		return
+	}
+	if systemResp.StatusCode() != http.StatusOK || systemResp.JSON200 == nil {
+		resp.Diagnostics.AddError("Failed to read system", formatResponseError(systemResp.HTTPResponse, systemResp.Body))
+		return
+	}
+
+	system := systemResp.JSON200
+	data.ID = types.StringValue(system.Id)
+	data.Slug = types.StringValue(system.Slug)
+	data.Name = types.StringValue(system.Name)
+	data.Description = descriptionValue(system.Description)
+	data.Metadata = stringMapValue(system.Metadata)
+
+	data.Environments = make([]SystemDataSourceEnvironment, 0, len(system.Environments))
+	for _, env := range system.Environments {
+		data.Environments = append(data.Environments, SystemDataSourceEnvironment{
+			ID:   types.StringValue(env.Id),
+			Name: types.StringValue(env.Name),
+		})
+	}
+
+	data.AppURL, data.EntityURL = entityURLs(d.workspace, "systems", data.ID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// findSystemIDBySlug returns the ID of the system with the given slug, or an
+// empty string when the workspace has none.
+func findSystemIDBySlug(ctx context.Context, workspace *api.WorkspaceClient, slug string) (string, error) {
+	for system, err := range api.Paginate(ctx, systemPages(workspace)) {
+		if err != nil {
+			return "", err
+		}
+		if system.Slug == slug {
+			return system.Id, nil
+		}
+	}
+	return "", nil
+}
+
+func systemPages(workspace *api.WorkspaceClient) api.PageFunc[api.System] {
+	return func(ctx context.Context, limit, offset int) (api.Page[api.System], error) {
+		listResp, err := workspace.Client.ListSystemsWithResponse(ctx, workspace.ID.String(), &api.ListSystemsParams{
+			Limit:  &limit,
+			Offset: &offset,
+		})
+		if err != nil {
+			return api.Page[api.System]{}, fmt.Errorf("failed to list systems: %w", err)
+		}
+		if listResp.StatusCode() != http.StatusOK || listResp.JSON200 == nil {
+			return api.Page[api.System]{}, fmt.Errorf("%s", formatResponseError(listResp.HTTPResponse, listResp.Body))
+		}
+		return api.Page[api.System]{Items: listResp.JSON200.Items, Total: listResp.JSON200.Total}, nil
+	}
+}
@@ -6,14 +6,10 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/ctrlplanedev/terraform-provider-ctrlplane/internal/api"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -23,6 +19,7 @@ var _ resource.Resource = &SystemResource{}
 
 var _ resource.ResourceWithImportState = &SystemResource{}
 var _ resource.ResourceWithConfigure = &SystemResource{}
+var _ resource.ResourceWithModifyPlan = &SystemResource{}
 
 func NewSystemResource() resource.Resource {
 	return &SystemResource{}
@@ -34,7 +31,7 @@ type SystemResource struct {
 
 // ImportState implements resource.ResourceWithImportState.
 func (r *SystemResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByNaturalKey(ctx, r.workspace, req, resp, resolveSystemSlug(r.workspace))
 }
 
 // Configure implements resource.ResourceWithConfigure.
@@ -62,8 +59,9 @@ func (r *SystemResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	requestBody := api.RequestSystemCreationJSONRequestBody{
 		Name:        data.Name.ValueString(),
+		Slug:        optionalSlug(data.Slug.StringValue),
 		Description: data.Description.ValueStringPointer(),
-		Metadata:    stringMapPointer(data.Metadata),
+		Metadata:    metadataRequest(r.workspace, data.Metadata),
 	}
 	workspaceId := r.workspace.ID
 	system, err := r.workspace.Client.RequestSystemCreationWithResponse(ctx, workspaceId.String(), requestBody)
@@ -73,7 +71,7 @@ func (r *SystemResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	if system.StatusCode() != http.StatusAccepted {
-		resp.Diagnostics.AddError("Failed to create system", formatResponseError(system.StatusCode(), system.Body))
+		addResponseError(ctx, &resp.Diagnostics, "Failed to create system", req.Plan, system.HTTPResponse, system.Body)
 		return
 	}
 
@@ -97,6 +95,9 @@ func (r *SystemResource) Create(ctx context.Context, req resource.CreateRequest,
 		}
 		switch getResp.StatusCode() {
 		case http.StatusOK:
+			if getResp.JSON200 != nil {
+				data.Slug = NewSlugValue(getResp.JSON200.Slug)
+			}
 			return true, nil
 		case http.StatusNotFound:
 			return false, nil
@@ -108,7 +109,11 @@ func (r *SystemResource) Create(ctx context.Context, req resource.CreateRequest,
 		resp.Diagnostics.AddError("Failed to create system", fmt.Sprintf("Resource not available after creation: %s", err.Error()))
 		return
 	}
+	if data.Slug.IsUnknown() {
+		data.Slug = NewSlugNull()
+	}
 
+	data.AppURL, data.EntityURL = entityURLs(r.workspace, "systems", data.ID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
 
@@ -142,7 +147,7 @@ func (r *SystemResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 
 	if clientResp.StatusCode() != http.StatusAccepted || clientResp.StatusCode() != http.StatusNoContent {
-		resp.Diagnostics.AddError("Failed to delete system", formatResponseError(clientResp.StatusCode(), clientResp.Body))
+		resp.Diagnostics.AddError("Failed to delete system", formatResponseError(clientResp.HTTPResponse, clientResp.Body))
 		return
 	}
 }
@@ -184,7 +189,7 @@ func (r *SystemResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	if system.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError("Failed to read system", formatResponseError(system.StatusCode(), system.Body))
+		resp.Diagnostics.AddError("Failed to read system", formatResponseError(system.HTTPResponse, system.Body))
 		return
 	}
 
@@ -197,10 +202,12 @@ func (r *SystemResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	data.Name = types.StringValue(system.JSON200.Name)
-	data.Description = descriptionValue(system.JSON200.Description)
-	data.Metadata = stringMapValue(system.JSON200.Metadata)
+	data.Name = NewTrimmedStringValue(system.JSON200.Name)
+	data.Slug = NewSlugValue(system.JSON200.Slug)
+	data.Description = NewTrimmedStringPointerValue(system.JSON200.Description)
+	data.Metadata = metadataFromAPI(r.workspace, system.JSON200.Metadata, data.Metadata)
 
+	data.AppURL, data.EntityURL = entityURLs(r.workspace, "systems", data.ID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -215,24 +222,40 @@ func (r *SystemResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"app_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "Link to the workspace in the Ctrlplane UI",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"entity_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "Link to this system in the Ctrlplane UI",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"name": schema.StringAttribute{
+				CustomType:  TrimmedStringType{},
 				Required:    true,
 				Description: "The name of the system",
 			},
-			"description": schema.StringAttribute{
+			"slug": schema.StringAttribute{
+				CustomType:  SlugType{},
 				Optional:    true,
-				Description: "The description of the system",
+				Computed:    true,
+				Description: "URL-safe identifier unique within the workspace. Derived from name if omitted; sticky once set. Changing it updates the system in place. Compared after trimming whitespace and lowercasing, as the server normalizes slugs.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"metadata": schema.MapAttribute{
+			"description": schema.StringAttribute{
+				CustomType:  TrimmedStringType{},
 				Optional:    true,
-				Computed:    true,
-				Description: "The metadata of the system",
-				ElementType: types.StringType,
-				Default: func() defaults.Map {
-					empty, _ := types.MapValueFrom(context.Background(), types.StringType, map[string]string{})
-					return mapdefault.StaticValue(empty)
-				}(),
+				Description: "The description of the system",
 			},
+			"metadata": metadataAttribute("The metadata of the system"),
 		},
 	}
 }
@@ -253,8 +276,9 @@ func (r *SystemResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	requestBody := api.RequestSystemUpsertJSONRequestBody{
 		Name:        data.Name.ValueString(),
+		Slug:        optionalSlug(data.Slug.StringValue),
 		Description: data.Description.ValueStringPointer(),
-		Metadata:    stringMapPointer(data.Metadata),
+		Metadata:    metadataRequest(r.workspace, data.Metadata),
 	}
 	system, err := r.workspace.Client.RequestSystemUpsertWithResponse(
 		ctx, r.workspace.ID.String(), data.ID.ValueString(), requestBody,
@@ -269,7 +293,7 @@ func (r *SystemResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	if system.StatusCode() != http.StatusAccepted {
-		resp.Diagnostics.AddError("Failed to update system", formatResponseError(system.StatusCode(), system.Body))
+		addResponseError(ctx, &resp.Diagnostics, "Failed to update system", req.Plan, system.HTTPResponse, system.Body)
 		return
 	}
 
@@ -281,6 +305,7 @@ func (r *SystemResource) Update(ctx context.Context, req resource.UpdateRequest,
 	systemId := system.JSON202.Id
 	data.ID = types.StringValue(systemId)
 
+	data.AppURL, data.EntityURL = entityURLs(r.workspace, "systems", data.ID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
 
@@ -288,21 +313,17 @@ func (r *SystemResource) Metadata(ctx context.Context, req resource.MetadataRequ
 	resp.TypeName = req.ProviderTypeName + "_system"
 }
 
-func formatResponseError(statusCode int, body []byte) string {
-	if len(body) > 0 {
-		return fmt.Sprintf("Status %d: %s", statusCode, strings.TrimSpace(string(body)))
-	}
-
-	if statusCode == 0 {
-		return "Missing response status from server"
-	}
-
-	return fmt.Sprintf("Status %d: %s", statusCode, http.StatusText(statusCode))
+func (r *SystemResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(planUnsetMetadata(ctx, r.workspace, req, &resp.Plan)...)
+	recordPlannedChange(ctx, r.workspace, "ctrlplane_system", req, resp)
 }
 
 type SystemResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	Metadata    types.Map    `tfsdk:"metadata"`
+	ID          types.String       `tfsdk:"id"`
+	Name        TrimmedStringValue `tfsdk:"name"`
+	Slug        SlugValue          `tfsdk:"slug"`
+	Description TrimmedStringValue `tfsdk:"description"`
+	Metadata    MetadataValue      `tfsdk:"metadata"`
+	AppURL      types.String       `tfsdk:"app_url"`
+	EntityURL   types.String       `tfsdk:"entity_url"`
 }
@@ -7,8 +7,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
@@ -76,3 +78,183 @@ resource "ctrlplane_system" "test" {
 }
 `, testAccProviderConfig(), name, description)
 }
+
+func TestAccSystemResource_slugUpdateInPlace(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-sys-slug-%d", time.Now().UnixNano())
+	slug := name + "-a"
+	updatedSlug := name + "-b"
+	sameID := statecheck.CompareValue(compare.ValuesSame())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSystemResourceSlugConfig(name, slug),
+				ConfigStateChecks: []statecheck.StateCheck{
+					sameID.AddStateValue("ctrlplane_system.test", tfjsonpath.New("id")),
+					statecheck.ExpectKnownValue(
+						"ctrlplane_system.test",
+						tfjsonpath.New("slug"),
+						knownvalue.StringExact(slug),
+					),
+				},
+			},
+			{
+				Config: testAccSystemResourceSlugConfig(name, updatedSlug),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("ctrlplane_system.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					sameID.AddStateValue("ctrlplane_system.test", tfjsonpath.New("id")),
+					statecheck.ExpectKnownValue(
+						"ctrlplane_system.test",
+						tfjsonpath.New("slug"),
+						knownvalue.StringExact(updatedSlug),
+					),
+				},
+			},
+			// Import by slug
+			{
+				ResourceName:      "ctrlplane_system.test",
+				ImportState:       true,
+				ImportStateId:     updatedSlug,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSystemResourceSlugConfig(name, slug string) string {
+	return fmt.Sprintf(`
+%s
+resource "ctrlplane_system" "test" {
+  name = %q
+  slug = %q
+  metadata = {
+    team = "platform"
+  }
+}
+`, testAccProviderConfig(), name, slug)
+}
+
+func TestAccSystemResource_normalizedValues(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-sys-norm-%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+resource "ctrlplane_system" "test" {
+  name        = "%s "
+  slug        = "%s-SLUG"
+  description = "Padded description  "
+}
+`, testAccProviderConfig(), name, name),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"ctrlplane_system.test",
+						tfjsonpath.New("slug"),
+						knownvalue.StringExact(name+"-SLUG"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccSystemResource_importBlock(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-import-block-%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSystemResourceConfig(name, "imported with an import block"),
+			},
+			{
+				Config:          testAccSystemResourceConfig(name, "imported with an import block"),
+				ResourceName:    "ctrlplane_system.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithID,
+			},
+		},
+	})
+}
+
+func TestAccSystemResource_defaultMetadata(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-default-metadata-%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSystemResourceDefaultMetadataConfig(name),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"ctrlplane_system.test",
+						tfjsonpath.New("metadata"),
+						knownvalue.MapExact(map[string]knownvalue.Check{
+							"tier": knownvalue.StringExact("gold"),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"data.ctrlplane_system.test",
+						tfjsonpath.New("metadata"),
+						knownvalue.MapExact(map[string]knownvalue.Check{
+							"team": knownvalue.StringExact("platform"),
+							"tier": knownvalue.StringExact("gold"),
+						}),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccSystemResourceDefaultMetadataConfig(name string) string {
+	return fmt.Sprintf(`
+terraform {
+  required_providers {
+    ctrlplane = {
+      source = "ctrlplanedev/ctrlplane"
+    }
+  }
+}
+
+provider "ctrlplane" {
+  default_metadata = {
+    team = "platform"
+    tier = "bronze"
+  }
+}
+
+resource "ctrlplane_system" "test" {
+  name = %q
+  metadata = {
+    tier = "gold"
+  }
+}
+
+data "ctrlplane_system" "test" {
+  slug = ctrlplane_system.test.slug
+}
+`, name)
+}
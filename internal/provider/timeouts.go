@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2021, 2026
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// resourceTimeouts is the model of the optional timeouts block. Each value
+// bounds the whole operation, including any polling done by waitForResource.
+type resourceTimeouts struct {
+	Create DurationValue `tfsdk:"create"`
+	Read   DurationValue `tfsdk:"read"`
+	Update DurationValue `tfsdk:"update"`
+	Delete DurationValue `tfsdk:"delete"`
+}
+
+func timeoutsBlock() schema.SingleNestedBlock {
+	attribute := func(operation string) schema.StringAttribute {
+		return schema.StringAttribute{
+			CustomType:  DurationType{},
+			Optional:    true,
+			Description: "Maximum time for " + operation + ", e.g. \"10m\". When unset, waiting for the API to apply a change is limited to " + formatDuration(waitForResourceTimeout) + ".",
+		}
+	}
+
+	return schema.SingleNestedBlock{
+		Description: "Operation timeouts",
+		Attributes: map[string]schema.Attribute{
+			"create": attribute("creation"),
+			"read":   attribute("reads"),
+			"update": attribute("updates"),
+			"delete": attribute("deletion"),
+		},
+	}
+}
+
+// withTimeout returns ctx bounded by value. When value is unset ctx gets no
+// deadline, so waitForResource falls back to waitForResourceTimeout.
+func withTimeout(ctx context.Context, value DurationValue) (context.Context, context.CancelFunc) {
+	if seconds, err := parseDurationSeconds(value); err == nil && seconds > 0 {
+		return context.WithTimeout(ctx, time.Duration(seconds)*time.Second)
+	}
+	return context.WithCancel(ctx)
+}
+
+// The accessors below return a null value when the timeouts block is absent.
+
+func (t *resourceTimeouts) create() DurationValue {
+	if t == nil {
+		return NewDurationNull()
+	}
+	return t.Create
+}
+
+func (t *resourceTimeouts) read() DurationValue {
+	if t == nil {
+		return NewDurationNull()
+	}
+	return t.Read
+}
+
+func (t *resourceTimeouts) update() DurationValue {
+	if t == nil {
+		return NewDurationNull()
+	}
+	return t.Update
+}
+
+func (t *resourceTimeouts) delete() DurationValue {
+	if t == nil {
+		return NewDurationNull()
+	}
+	return t.Delete
+}
@@ -22,6 +22,7 @@ import (
 var _ resource.Resource = &VariableSetResource{}
 var _ resource.ResourceWithImportState = &VariableSetResource{}
 var _ resource.ResourceWithConfigure = &VariableSetResource{}
+var _ resource.ResourceWithModifyPlan = &VariableSetResource{}
 
 func NewVariableSetResource() resource.Resource {
 	return &VariableSetResource{}
@@ -60,6 +61,10 @@ func (r *VariableSetResource) Metadata(ctx context.Context, req resource.Metadat
 	resp.TypeName = req.ProviderTypeName + "_variable_set"
 }
 
+func (r *VariableSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	recordPlannedChange(ctx, r.workspace, "ctrlplane_variable_set", req, resp)
+}
+
 func (r *VariableSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -180,7 +185,7 @@ func (r *VariableSetResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	if createResp.StatusCode() != http.StatusCreated {
-		resp.Diagnostics.AddError("Failed to create variable set", formatResponseError(createResp.StatusCode(), createResp.Body))
+		addResponseError(ctx, &resp.Diagnostics, "Failed to create variable set", req.Plan, createResp.HTTPResponse, createResp.Body)
 		return
 	}
 
@@ -247,7 +252,7 @@ func (r *VariableSetResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	if getResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError("Failed to read variable set", formatResponseError(getResp.StatusCode(), getResp.Body))
+		resp.Diagnostics.AddError("Failed to read variable set", formatResponseError(getResp.HTTPResponse, getResp.Body))
 		return
 	}
 
@@ -302,7 +307,7 @@ func (r *VariableSetResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	if updateResp.StatusCode() != http.StatusAccepted {
-		resp.Diagnostics.AddError("Failed to update variable set", formatResponseError(updateResp.StatusCode(), updateResp.Body))
+		addResponseError(ctx, &resp.Diagnostics, "Failed to update variable set", req.Plan, updateResp.HTTPResponse, updateResp.Body)
 		return
 	}
 
@@ -345,7 +350,7 @@ func (r *VariableSetResource) Delete(ctx context.Context, req resource.DeleteReq
 		}
 	}
 
-	resp.Diagnostics.AddError("Failed to delete variable set", formatResponseError(deleteResp.StatusCode(), deleteResp.Body))
+	resp.Diagnostics.AddError("Failed to delete variable set", formatResponseError(deleteResp.HTTPResponse, deleteResp.Body))
 }
 
 // vsVariablesFromModel converts the Terraform list of variables into API VariableSetVariable slice.
@@ -8,6 +8,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"regexp"
+	"sort"
+	"strings"
 
 	"github.com/ctrlplanedev/terraform-provider-ctrlplane/internal/api"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -21,6 +24,8 @@ import (
 var _ resource.Resource = &WorkflowResource{}
 var _ resource.ResourceWithImportState = &WorkflowResource{}
 var _ resource.ResourceWithConfigure = &WorkflowResource{}
+var _ resource.ResourceWithModifyPlan = &WorkflowResource{}
+var _ resource.ResourceWithValidateConfig = &WorkflowResource{}
 
 func NewWorkflowResource() resource.Resource {
 	return &WorkflowResource{}
@@ -49,6 +54,10 @@ func (r *WorkflowResource) Metadata(_ context.Context, req resource.MetadataRequ
 	resp.TypeName = req.ProviderTypeName + "_workflow"
 }
 
+func (r *WorkflowResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	recordPlannedChange(ctx, r.workspace, "ctrlplane_workflow", req, resp)
+}
+
 func (r *WorkflowResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -104,7 +113,7 @@ func (r *WorkflowResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 						},
 						"ref": schema.StringAttribute{
 							Required:    true,
-							Description: "ID of the job agent to reference.",
+							Description: "ID of the job agent to reference. Referencing a ctrlplane_job_agent's id creates the agent before the workflow.",
 						},
 						"config": schema.MapAttribute{
 							Required:    true,
@@ -113,7 +122,7 @@ func (r *WorkflowResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 						},
 						"selector": schema.StringAttribute{
 							Required:    true,
-							Description: "CEL expression to determine if the job agent should dispatch. Use \"true\" to always dispatch.",
+							Description: "CEL expression to determine if the job agent should dispatch. Use \"true\" to always dispatch. References to inputs.<key> must name a key declared in inputs.",
 						},
 					},
 				},
@@ -122,6 +131,45 @@ func (r *WorkflowResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 	}
 }
 
+func (r *WorkflowResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data WorkflowResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Inputs.IsUnknown() {
+		return
+	}
+
+	inputs, err := parseWorkflowInputs(data.Inputs)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("inputs"), "Invalid inputs", err.Error())
+		return
+	}
+
+	declared := workflowInputKeys(inputs)
+	for i, agent := range data.JobAgents {
+		if agent.Selector.IsNull() || agent.Selector.IsUnknown() {
+			continue
+		}
+		for _, key := range workflowInputReferences(agent.Selector.ValueString()) {
+			if _, ok := declared[key]; ok {
+				continue
+			}
+			detail := fmt.Sprintf("Selector references inputs.%s, which is not declared in inputs.", key)
+			if suggestion := closestWorkflowInputKey(key, declared); suggestion != "" {
+				detail += fmt.Sprintf(" Did you mean inputs.%s?", suggestion)
+			}
+			resp.Diagnostics.AddAttributeError(
+				path.Root("job_agent").AtListIndex(i).AtName("selector"),
+				"Unknown workflow input",
+				detail,
+			)
+		}
+	}
+}
+
 func (r *WorkflowResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data WorkflowResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -149,7 +197,7 @@ func (r *WorkflowResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	if createResp.StatusCode() != http.StatusCreated {
-		resp.Diagnostics.AddError("Failed to create workflow", formatResponseError(createResp.StatusCode(), createResp.Body))
+		addResponseError(ctx, &resp.Diagnostics, "Failed to create workflow", req.Plan, createResp.HTTPResponse, createResp.Body)
 		return
 	}
 
@@ -185,7 +233,7 @@ func (r *WorkflowResource) Read(ctx context.Context, req resource.ReadRequest, r
 		resp.State.RemoveResource(ctx)
 		return
 	default:
-		resp.Diagnostics.AddError("Failed to read workflow", formatResponseError(getResp.StatusCode(), getResp.Body))
+		resp.Diagnostics.AddError("Failed to read workflow", formatResponseError(getResp.HTTPResponse, getResp.Body))
 		return
 	}
 
@@ -220,7 +268,7 @@ func (r *WorkflowResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	if updateResp.StatusCode() != http.StatusAccepted {
-		resp.Diagnostics.AddError("Failed to update workflow", formatResponseError(updateResp.StatusCode(), updateResp.Body))
+		addResponseError(ctx, &resp.Diagnostics, "Failed to update workflow", req.Plan, updateResp.HTTPResponse, updateResp.Body)
 		return
 	}
 
@@ -252,7 +300,7 @@ func (r *WorkflowResource) Delete(ctx context.Context, req resource.DeleteReques
 	case http.StatusNotFound:
 		return
 	default:
-		resp.Diagnostics.AddError("Failed to delete workflow", formatResponseError(deleteResp.StatusCode(), deleteResp.Body))
+		resp.Diagnostics.AddError("Failed to delete workflow", formatResponseError(deleteResp.HTTPResponse, deleteResp.Body))
 	}
 }
 
@@ -342,3 +390,79 @@ func setWorkflowModelFromAPI(data *WorkflowResourceModel, w *api.Workflow) {
 	}
 	data.JobAgents = agents
 }
+
+var (
+	workflowInputDotReference     = regexp.MustCompile(`(?:^|[^.\w])inputs\s*\.\s*([A-Za-z_][A-Za-z0-9_]*)`)
+	workflowInputBracketReference = regexp.MustCompile(`(?:^|[^.\w])inputs\s*\[\s*["']([^"']+)["']\s*\]`)
+)
+
+func workflowInputKeys(inputs []api.WorkflowInput) map[string]struct{} {
+	keys := make(map[string]struct{}, len(inputs))
+	for _, input := range inputs {
+		raw, err := json.Marshal(input)
+		if err != nil {
+			continue
+		}
+		var keyed struct {
+			Key string `json:"key"`
+		}
+		if err := json.Unmarshal(raw, &keyed); err == nil && keyed.Key != "" {
+			keys[keyed.Key] = struct{}{}
+		}
+	}
+	return keys
+}
+
+// workflowInputReferences returns the input keys a CEL expression reads via
+// inputs.key or inputs["key"], in order of first appearance.
+func workflowInputReferences(expression string) []string {
+	seen := make(map[string]bool)
+	var keys []string
+	for _, pattern := range []*regexp.Regexp{workflowInputDotReference, workflowInputBracketReference} {
+		for _, match := range pattern.FindAllStringSubmatch(expression, -1) {
+			if !seen[match[1]] {
+				seen[match[1]] = true
+				keys = append(keys, match[1])
+			}
+		}
+	}
+	return keys
+}
+
+// closestWorkflowInputKey returns the declared key within two edits of key,
+// or "" when nothing is close enough to be a likely typo.
+func closestWorkflowInputKey(key string, declared map[string]struct{}) string {
+	candidates := make([]string, 0, len(declared))
+	for candidate := range declared {
+		candidates = append(candidates, candidate)
+	}
+	sort.Strings(candidates)
+
+	best, bestDistance := "", 3
+	for _, candidate := range candidates {
+		if distance := editDistance(strings.ToLower(key), strings.ToLower(candidate)); distance < bestDistance {
+			best, bestDistance = candidate, distance
+		}
+	}
+	return best
+}
+
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current := make([]int, len(b)+1)
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous = current
+	}
+	return previous[len(b)]
+}
@@ -5,6 +5,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
@@ -96,6 +97,21 @@ func TestAccWorkflowResource_ExplicitSlug(t *testing.T) {
 	})
 }
 
+func TestAccWorkflowResource_UnknownInputReference(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-wf-inputs-%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccWorkflowConfigWithSelector(name, `inputs.regin == "us-east-1"`),
+				ExpectError: regexp.MustCompile(`(?s)inputs\.regin.*Did you mean\s+inputs\.region\?`),
+			},
+		},
+	})
+}
+
 func testAccWorkflowConfig(name string) string {
 	return fmt.Sprintf(`
 %s
@@ -148,3 +164,21 @@ resource "ctrlplane_workflow" "test" {
 }
 `, testAccProviderConfig(), name+"-agent", name, slug)
 }
+
+func testAccWorkflowConfigWithSelector(name, selector string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "ctrlplane_workflow" "test" {
+  name   = %q
+  inputs = jsonencode([{ key = "region", type = "string" }])
+
+  job_agent {
+    name     = "test-agent"
+    ref      = "00000000-0000-0000-0000-000000000000"
+    config   = {}
+    selector = %q
+  }
+}
+`, testAccProviderConfig(), name, selector)
+}
@@ -0,0 +1,225 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/ctrlplanedev/terraform-provider-ctrlplane/internal/api"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &WorkspaceInventoryDataSource{}
+var _ datasource.DataSourceWithConfigure = &WorkspaceInventoryDataSource{}
+
+func NewWorkspaceInventoryDataSource() datasource.DataSource {
+	return &WorkspaceInventoryDataSource{}
+}
+
+// WorkspaceInventoryDataSource lists the IDs of every entity the provider
+// manages, mainly so that import blocks can be generated for an existing
+// workspace.
+type WorkspaceInventoryDataSource struct {
+	workspace *api.WorkspaceClient
+}
+
+type WorkspaceInventoryDataSourceModel struct {
+	Metadata     map[string]string          `tfsdk:"metadata"`
+	Systems      []WorkspaceInventoryEntity `tfsdk:"systems"`
+	Environments []WorkspaceInventoryEntity `tfsdk:"environments"`
+	Deployments  []WorkspaceInventoryEntity `tfsdk:"deployments"`
+	Policies     []WorkspaceInventoryEntity `tfsdk:"policies"`
+	JobAgents    []WorkspaceInventoryAgent  `tfsdk:"job_agents"`
+}
+
+type WorkspaceInventoryEntity struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	Slug types.String `tfsdk:"slug"`
+}
+
+type WorkspaceInventoryAgent struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+}
+
+func (d *WorkspaceInventoryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_inventory"
+}
+
+func (d *WorkspaceInventoryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	entity := func(kind string) schema.ListNestedAttribute {
+		attributes := map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the " + kind,
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the " + kind,
+			},
+			"slug": schema.StringAttribute{
+				Computed:    true,
+				Description: "The slug of the " + kind + ", or null when it has none",
+			},
+		}
+		return schema.ListNestedAttribute{
+			Computed:     true,
+			NestedObject: schema.NestedAttributeObject{Attributes: attributes},
+		}
+	}
+
+	systems := entity("system")
+	systems.Description = "The systems in the workspace, ordered as returned by the API"
+	environments := entity("environment")
+	environments.Description = "The environments in the workspace, ordered as returned by the API"
+	deployments := entity("deployment")
+	deployments.Description = "The deployments in the workspace, ordered as returned by the API"
+	policies := entity("policy")
+	policies.Description = "The policies in the workspace, ordered as returned by the API"
+
+	resp.Schema = schema.Schema{
+		Description: "List the IDs of all systems, environments, deployments, policies and job agents in the configured workspace, " +
+			"for example to generate import blocks for an existing workspace.",
+		Attributes: map[string]schema.Attribute{
+			"metadata": schema.MapAttribute{
+				Optional: true,
+				Description: "Only return entities whose metadata contains all of these key/value pairs. " +
+					"The list endpoints cannot filter by metadata, so the filter is applied by the provider after listing.",
+				ElementType: types.StringType,
+			},
+			"systems":      systems,
+			"environments": environments,
+			"deployments":  deployments,
+			"policies":     policies,
+			"job_agents": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The job agents in the workspace, ordered as returned by the API",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the job agent",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the job agent",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of the job agent",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *WorkspaceInventoryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	workspace, ok := req.ProviderData.(*api.WorkspaceClient)
+	if !ok {
+		resp.Diagnostics.AddError("Invalid provider data", "The provider data is not a *api.WorkspaceClient")
+		return
+	}
+
+	d.workspace = workspace
+}
+
+func (d *WorkspaceInventoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data WorkspaceInventoryDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	systems, err := api.Collect(api.Paginate(ctx, systemPages(d.workspace)))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list systems", err.Error())
+		return
+	}
+	data.Systems = []WorkspaceInventoryEntity{}
+	for _, system := range systems {
+		if metadataContains(system.Metadata, data.Metadata) {
+			data.Systems = append(data.Systems, WorkspaceInventoryEntity{
+				ID:   types.StringValue(system.Id),
+				Name: types.StringValue(system.Name),
+				Slug: types.StringValue(system.Slug),
+			})
+		}
+	}
+
+	environments, err := api.Collect(api.Paginate(ctx, environmentPages(d.workspace)))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list environments", err.Error())
+		return
+	}
+	data.Environments = []WorkspaceInventoryEntity{}
+	for _, env := range environments {
+		if metadataContains(env.Metadata, data.Metadata) {
+			data.Environments = append(data.Environments, WorkspaceInventoryEntity{
+				ID:   types.StringValue(env.Id),
+				Name: types.StringValue(env.Name),
+				Slug: types.StringNull(),
+			})
+		}
+	}
+
+	deployments, err := listDeployments(ctx, d.workspace, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list deployments", err.Error())
+		return
+	}
+	data.Deployments = []WorkspaceInventoryEntity{}
+	for _, item := range deployments {
+		if metadataContains(item.Deployment.Metadata, data.Metadata) {
+			data.Deployments = append(data.Deployments, WorkspaceInventoryEntity{
+				ID:   types.StringValue(item.Deployment.Id),
+				Name: types.StringValue(item.Deployment.Name),
+				Slug: types.StringValue(item.Deployment.Slug),
+			})
+		}
+	}
+
+	policies, err := api.Collect(api.Paginate(ctx, policyPages(d.workspace)))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list policies", err.Error())
+		return
+	}
+	data.Policies = []WorkspaceInventoryEntity{}
+	for _, policy := range policies {
+		if metadataContains(&policy.Metadata, data.Metadata) {
+			data.Policies = append(data.Policies, WorkspaceInventoryEntity{
+				ID:   types.StringValue(policy.Id),
+				Name: types.StringValue(policy.Name),
+				Slug: types.StringNull(),
+			})
+		}
+	}
+
+	agents, err := api.Collect(api.Paginate(ctx, jobAgentPages(d.workspace)))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list job agents", err.Error())
+		return
+	}
+	data.JobAgents = []WorkspaceInventoryAgent{}
+	for _, agent := range agents {
+		if metadataContains(&agent.Metadata, data.Metadata) {
+			data.JobAgents = append(data.JobAgents, WorkspaceInventoryAgent{
+				ID:   types.StringValue(agent.Id),
+				Name: types.StringValue(agent.Name),
+				Type: types.StringValue(agent.Type),
+			})
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2021, 2026
+
+package validator
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = &CELValidator{}
+
+// CELValidator checks that a string parses as a CEL expression. It only checks
+// syntax: the variables available to a selector (resource, deployment,
+// environment, version, ...) depend on where the server evaluates it.
+type CELValidator struct {
+	env *cel.Env
+}
+
+func NewCELValidator() validator.String {
+	env, err := cel.NewEnv()
+	if err != nil {
+		// The default environment has no declarations that could fail.
+		panic(err)
+	}
+	return &CELValidator{env: env}
+}
+
+// Description implements validator.String.
+func (v *CELValidator) Description(context.Context) string {
+	return "must be a valid CEL expression"
+}
+
+// MarkdownDescription implements validator.String.
+func (v *CELValidator) MarkdownDescription(context.Context) string {
+	return "must be a valid CEL expression"
+}
+
+func (v *CELValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() {
+		return
+	}
+
+	if req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	expression := req.ConfigValue.ValueString()
+	if strings.TrimSpace(expression) == "" {
+		return
+	}
+
+	if _, issues := v.env.Parse(expression); issues != nil && issues.Err() != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid CEL expression",
+			strings.ReplaceAll(issues.String(), "<input>", req.Path.String()),
+		)
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright IBM Corp. 2021, 2026
+
+package validator
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/teambition/rrule-go"
+)
+
+var _ validator.String = &RRuleValidator{}
+
+// RRuleValidator checks that a string is an RFC 5545 recurrence rule, such as
+// FREQ=WEEKLY;BYDAY=MO,TU;BYHOUR=9, optionally preceded by a DTSTART line.
+type RRuleValidator struct{}
+
+func NewRRuleValidator() validator.String {
+	return &RRuleValidator{}
+}
+
+// Description implements validator.String.
+func (v *RRuleValidator) Description(context.Context) string {
+	return "must be a valid RFC 5545 recurrence rule"
+}
+
+// MarkdownDescription implements validator.String.
+func (v *RRuleValidator) MarkdownDescription(context.Context) string {
+	return "must be a valid RFC 5545 recurrence rule"
+}
+
+func (v *RRuleValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() {
+		return
+	}
+
+	if req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := ValidateRRule(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid recurrence rule", err.Error())
+	}
+}
+
+// Recurrence is a parsed recurrence rule.
+type Recurrence struct {
+	// Options holds the parts of the RRULE line.
+	Options rrule.ROption
+	// DTStart is the value of the DTSTART line, or zero without one.
+	DTStart time.Time
+}
+
+var rruleWeekdays = []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// ValidateRRule parses rule with ParseRRule and returns an error describing
+// the first problem found.
+func ValidateRRule(rule string) error {
+	_, err := ParseRRule(rule)
+	return err
+}
+
+// ParseRRule parses an RFC 5545 recurrence: a bare recur value such as
+// FREQ=WEEKLY;BYDAY=MO,TU;BYHOUR=9, or newline-separated content lines with
+// exactly one RRULE, an optional leading DTSTART, and any RDATE and EXDATE
+// lines, such as "DTSTART:20000101T160000\nRRULE:FREQ=WEEKLY;BYDAY=MO".
+func ParseRRule(rule string) (*Recurrence, error) {
+	var lines []string
+	var recur string
+	rules := 0
+	for _, line := range strings.Split(strings.TrimSpace(rule), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		name, value := rruleContentLine(line)
+		switch name {
+		case "RRULE":
+			rules++
+			recur = strings.ToUpper(value)
+			line = "RRULE:" + recur
+		case "DTSTART":
+			if len(lines) > 0 {
+				return nil, fmt.Errorf("DTSTART must be the first line")
+			}
+		case "RDATE", "EXDATE":
+		default:
+			return nil, fmt.Errorf("unsupported line %q: expected DTSTART, RRULE, RDATE, or EXDATE", line)
+		}
+		lines = append(lines, line)
+	}
+	switch {
+	case len(lines) == 0:
+		return nil, fmt.Errorf("recurrence rule is empty")
+	case rules == 0:
+		return nil, fmt.Errorf("an RRULE line is required")
+	case rules > 1:
+		return nil, fmt.Errorf("only one RRULE line is allowed, got %d", rules)
+	}
+
+	options, err := parseRecur(recur)
+	if err != nil {
+		return nil, err
+	}
+	set, err := rrule.StrSliceToRRuleSet(lines)
+	if err != nil {
+		return nil, fmt.Errorf("invalid DTSTART, RDATE, or EXDATE line: %w", err)
+	}
+	return &Recurrence{Options: *options, DTStart: set.GetDTStart()}, nil
+}
+
+// rruleContentLine splits a content line into its upper-case name and its
+// value. A line without a name, such as FREQ=DAILY, is an RRULE value.
+func rruleContentLine(line string) (string, string) {
+	end := strings.IndexAny(line, ";:")
+	if end < 0 || strings.Contains(line[:end], "=") {
+		return "RRULE", line
+	}
+	name := strings.ToUpper(line[:end])
+	if name == "RRULE" {
+		return name, line[end+1:]
+	}
+	return name, line
+}
+
+// parseRecur parses an RRULE value and checks the RFC 5545 constraints that
+// rrule-go does not: rule parts that may only be used with some frequencies,
+// positive COUNT and INTERVAL, and parts that are not allowed together.
+func parseRecur(recur string) (*rrule.ROption, error) {
+	parts := map[string]string{}
+	for _, part := range strings.Split(recur, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		if !ok || name == "" || value == "" {
+			return nil, fmt.Errorf("%q is not a NAME=VALUE rule part", part)
+		}
+		if _, seen := parts[name]; seen {
+			return nil, fmt.Errorf("%s is specified more than once", name)
+		}
+		parts[name] = value
+	}
+
+	options, err := rrule.StrToROption(recur)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := rrule.NewRRule(*options); err != nil {
+		return nil, err
+	}
+
+	freq := parts["FREQ"]
+	for _, name := range []string{"COUNT", "INTERVAL"} {
+		if value, ok := parts[name]; ok {
+			if n, err := strconv.Atoi(value); err != nil || n < 1 {
+				return nil, fmt.Errorf("%s must be a positive integer, got %q", name, value)
+			}
+		}
+	}
+	if _, ok := parts["WKST"]; ok && !slices.Contains(rruleWeekdays, parts["WKST"]) {
+		return nil, fmt.Errorf("WKST must be one of %s, got %q", strings.Join(rruleWeekdays, ", "), parts["WKST"])
+	}
+	if _, ok := parts["UNTIL"]; ok {
+		if _, ok := parts["COUNT"]; ok {
+			return nil, fmt.Errorf("UNTIL and COUNT cannot both be specified")
+		}
+	}
+	if len(options.Bymonthday) > 0 && options.Freq == rrule.WEEKLY {
+		return nil, fmt.Errorf("BYMONTHDAY cannot be used with FREQ=WEEKLY")
+	}
+	if len(options.Byyearday) > 0 && (options.Freq == rrule.DAILY || options.Freq == rrule.WEEKLY || options.Freq == rrule.MONTHLY) {
+		return nil, fmt.Errorf("BYYEARDAY cannot be used with FREQ=%s", freq)
+	}
+	if len(options.Byweekno) > 0 && options.Freq != rrule.YEARLY {
+		return nil, fmt.Errorf("BYWEEKNO can only be used with FREQ=YEARLY")
+	}
+	for _, weekday := range options.Byweekday {
+		if weekday.N() == 0 {
+			continue
+		}
+		if options.Freq != rrule.MONTHLY && options.Freq != rrule.YEARLY {
+			return nil, fmt.Errorf("BYDAY ordinals such as %q can only be used with FREQ=MONTHLY or FREQ=YEARLY", weekday.String())
+		}
+		if len(options.Byweekno) > 0 {
+			return nil, fmt.Errorf("BYDAY ordinals such as %q cannot be used together with BYWEEKNO", weekday.String())
+		}
+	}
+	if len(options.Bysetpos) > 0 {
+		hasOther := false
+		for name := range parts {
+			if strings.HasPrefix(name, "BY") && name != "BYSETPOS" {
+				hasOther = true
+			}
+		}
+		if !hasOther {
+			return nil, fmt.Errorf("BYSETPOS must be used together with another BYxxx rule part")
+		}
+	}
+	return options, nil
+}
@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2021, 2026
+
+package validator
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/teambition/rrule-go"
+)
+
+func TestValidateRRule(t *testing.T) {
+	cases := map[string]struct {
+		rule    string
+		wantErr string
+	}{
+		"bare recur":            {rule: "FREQ=WEEKLY;BYDAY=MO,TU;BYHOUR=9"},
+		"rrule prefix":          {rule: "RRULE:FREQ=DAILY;BYHOUR=22"},
+		"lower case":            {rule: "rrule:freq=daily;byhour=22"},
+		"dtstart":               {rule: "DTSTART:20000101T160000\nRRULE:FREQ=WEEKLY;WKST=MO;BYDAY=MO,TU,WE,TH,FR"},
+		"dtstart with tzid":     {rule: "DTSTART;TZID=America/New_York:20000101T160000\nRRULE:FREQ=DAILY"},
+		"exdate and rdate":      {rule: "DTSTART:20250101T090000Z\nRRULE:FREQ=DAILY\nEXDATE:20251225T090000Z\nRDATE:20251226T090000Z"},
+		"crlf":                  {rule: "DTSTART:20000101T160000\r\nRRULE:FREQ=DAILY"},
+		"ordinal monthly":       {rule: "FREQ=MONTHLY;BYDAY=-1FR"},
+		"until":                 {rule: "FREQ=DAILY;UNTIL=20301231T000000Z"},
+		"bysetpos with byday":   {rule: "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1"},
+		"empty":                 {rule: "  ", wantErr: "empty"},
+		"missing freq":          {rule: "BYHOUR=9", wantErr: "FREQ is required"},
+		"unknown freq":          {rule: "FREQ=FORTNIGHTLY", wantErr: "FORTNIGHTLY"},
+		"bad part":              {rule: "FREQ=DAILY;BYHOUR", wantErr: "NAME=VALUE"},
+		"duplicate part":        {rule: "FREQ=DAILY;BYHOUR=1;BYHOUR=2", wantErr: "more than once"},
+		"unknown part":          {rule: "FREQ=DAILY;BYFOO=1", wantErr: "BYFOO"},
+		"hour out of range":     {rule: "FREQ=DAILY;BYHOUR=24", wantErr: "byhour must be between 0 and 23"},
+		"zero interval":         {rule: "FREQ=DAILY;INTERVAL=0", wantErr: "INTERVAL must be a positive integer"},
+		"until and count":       {rule: "FREQ=DAILY;COUNT=3;UNTIL=20301231", wantErr: "UNTIL and COUNT"},
+		"ordinal weekly":        {rule: "FREQ=WEEKLY;BYDAY=1MO", wantErr: "FREQ=MONTHLY or FREQ=YEARLY"},
+		"monthday weekly":       {rule: "FREQ=WEEKLY;BYMONTHDAY=1", wantErr: "BYMONTHDAY cannot be used with FREQ=WEEKLY"},
+		"weekno monthly":        {rule: "FREQ=MONTHLY;BYWEEKNO=1", wantErr: "BYWEEKNO can only be used with FREQ=YEARLY"},
+		"yearday daily":         {rule: "FREQ=DAILY;BYYEARDAY=1", wantErr: "BYYEARDAY cannot be used with FREQ=DAILY"},
+		"bysetpos alone":        {rule: "FREQ=MONTHLY;BYSETPOS=1", wantErr: "BYSETPOS must be used together"},
+		"wkst ordinal":          {rule: "FREQ=WEEKLY;WKST=2MO", wantErr: "WKST must be one of"},
+		"dtstart not first":     {rule: "RRULE:FREQ=DAILY\nDTSTART:20000101T160000", wantErr: "DTSTART must be the first line"},
+		"no rrule line":         {rule: "DTSTART:20000101T160000", wantErr: "RRULE line is required"},
+		"two rrule lines":       {rule: "RRULE:FREQ=DAILY\nRRULE:FREQ=WEEKLY", wantErr: "only one RRULE line"},
+		"unsupported line":      {rule: "RRULE:FREQ=DAILY\nEXRULE:FREQ=WEEKLY", wantErr: "unsupported line"},
+		"bad dtstart":           {rule: "DTSTART:tomorrow\nRRULE:FREQ=DAILY", wantErr: "DTSTART"},
+		"bad dtstart time zone": {rule: "DTSTART;TZID=Mars/Olympus:20000101T160000\nRRULE:FREQ=DAILY", wantErr: "Mars/Olympus"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateRRule(tc.rule)
+			switch {
+			case tc.wantErr == "" && err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case tc.wantErr != "" && err == nil:
+				t.Fatalf("expected an error containing %q", tc.wantErr)
+			case tc.wantErr != "" && !strings.Contains(err.Error(), tc.wantErr):
+				t.Fatalf("got error %q, want it to contain %q", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseRRule(t *testing.T) {
+	recurrence, err := ParseRRule("DTSTART:20000101T163000\nRRULE:FREQ=DAILY;BYHOUR=9,17;INTERVAL=2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if recurrence.Options.Freq != rrule.DAILY {
+		t.Errorf("Freq = %v, want DAILY", recurrence.Options.Freq)
+	}
+	if recurrence.Options.Interval != 2 {
+		t.Errorf("Interval = %d, want 2", recurrence.Options.Interval)
+	}
+	if got := recurrence.Options.Byhour; len(got) != 2 || got[0] != 9 || got[1] != 17 {
+		t.Errorf("Byhour = %v, want [9 17]", got)
+	}
+	if want := time.Date(2000, 1, 1, 16, 30, 0, 0, time.UTC); !recurrence.DTStart.Equal(want) {
+		t.Errorf("DTStart = %s, want %s", recurrence.DTStart, want)
+	}
+
+	recurrence, err = ParseRRule("FREQ=WEEKLY")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !recurrence.DTStart.IsZero() {
+		t.Errorf("DTStart = %s, want zero without a DTSTART line", recurrence.DTStart)
+	}
+}